@@ -0,0 +1,18 @@
+// Command gorm is the CLI front-end for the generator and related
+// developer tooling in this module.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/go-gorm/cli/internal/cli"
+)
+
+func main() {
+	err := cli.Execute()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+	}
+	os.Exit(cli.ExitCode(err))
+}
@@ -0,0 +1,49 @@
+package field
+
+import "gorm.io/gorm/clause"
+
+// Array wraps a Postgres array column of element type T.
+type Array[T any] struct {
+	Field
+}
+
+// NewArray constructs an Array[T] field for the given table/column.
+func NewArray[T any](table, column string) Array[T] {
+	return Array[T]{NewField(table, column)}
+}
+
+// WithTable returns a copy of f scoped to a different table name.
+func (f Array[T]) WithTable(table string) Array[T] {
+	f.Field = f.Field.WithTable(table)
+	return f
+}
+
+// Set builds an assignment replacing the column with value, for use with Interface[T].Set.
+func (f Array[T]) Set(value []T) Assignment {
+	return clause.Assignment{Column: f.assignColumn(), Value: value}
+}
+
+// Append builds `column = array_append(column, v)`, for use with Interface[T].Set.
+func (f Array[T]) Append(v T) Assignment {
+	return clause.Assignment{
+		Column: f.assignColumn(),
+		Value:  clause.Expr{SQL: "array_append(?,?)", Vars: []interface{}{f.Column(), v}},
+	}
+}
+
+// Remove builds `column = array_remove(column, v)`, for use with Interface[T].Set.
+func (f Array[T]) Remove(v T) Assignment {
+	return clause.Assignment{
+		Column: f.assignColumn(),
+		Value:  clause.Expr{SQL: "array_remove(?,?)", Vars: []interface{}{f.Column(), v}},
+	}
+}
+
+// Concat builds `column = column || vals`, appending every element of
+// vals in one statement, for use with Interface[T].Set.
+func (f Array[T]) Concat(vals []T) Assignment {
+	return clause.Assignment{
+		Column: f.assignColumn(),
+		Value:  clause.Expr{SQL: "? || ?", Vars: []interface{}{f.Column(), vals}},
+	}
+}
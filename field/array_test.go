@@ -0,0 +1,29 @@
+package field
+
+import (
+	"testing"
+
+	"gorm.io/gorm/clause"
+)
+
+func TestArrayAssignments(t *testing.T) {
+	tags := NewArray[string]("posts", "tags")
+
+	append_ := tags.Append("go")
+	wantCol := clause.Column{Name: "tags"}
+	if append_.Column != wantCol {
+		t.Fatalf("Append: column = %#v, want %#v", append_.Column, wantCol)
+	}
+	var b fakeBuilder
+	append_.Value.(interface{ Build(clause.Builder) }).Build(&b)
+	if want := "array_append(tags,go)"; b.String() != want {
+		t.Errorf("Append: got %q, want %q", b.String(), want)
+	}
+
+	remove := tags.Remove("go")
+	b = fakeBuilder{}
+	remove.Value.(interface{ Build(clause.Builder) }).Build(&b)
+	if want := "array_remove(tags,go)"; b.String() != want {
+		t.Errorf("Remove: got %q, want %q", b.String(), want)
+	}
+}
@@ -0,0 +1,15 @@
+package field
+
+import "gorm.io/gorm/clause"
+
+// SetCol builds an assignment copying another column's value, e.g.
+// `SET archived_name = name`, for use with Interface[T].Set.
+func (f Field) SetCol(other Columner) Assignment {
+	return clause.Assignment{Column: f.assignColumn(), Value: other.Column()}
+}
+
+// SetExpr builds an assignment from an arbitrary expression, e.g.
+// `SET total = price * quantity`, for use with Interface[T].Set.
+func (f Field) SetExpr(expr Expr) Assignment {
+	return clause.Assignment{Column: f.assignColumn(), Value: expr}
+}
@@ -0,0 +1,27 @@
+package field
+
+import (
+	"reflect"
+	"testing"
+
+	"gorm.io/gorm/clause"
+)
+
+func TestSetColAndSetExpr(t *testing.T) {
+	archivedName := NewField("orders", "archived_name")
+	name := NewField("orders", "name")
+
+	got := archivedName.SetCol(name)
+	want := clause.Assignment{Column: clause.Column{Name: "archived_name"}, Value: name.Column()}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SetCol: got %#v, want %#v", got, want)
+	}
+
+	total := NewField("orders", "total")
+	expr := clause.Expr{SQL: "price * quantity"}
+	gotExpr := total.SetExpr(expr)
+	wantExpr := clause.Assignment{Column: clause.Column{Name: "total"}, Value: expr}
+	if !reflect.DeepEqual(gotExpr, wantExpr) {
+		t.Errorf("SetExpr: got %#v, want %#v", gotExpr, wantExpr)
+	}
+}
@@ -0,0 +1,55 @@
+package field
+
+import "gorm.io/gorm/clause"
+
+// Bool is a typed wrapper for boolean columns.
+type Bool struct {
+	Field
+}
+
+// NewBool constructs a Bool field for the given table/column. Generated
+// code calls this when building a model's field set.
+func NewBool(table, column string) Bool {
+	return Bool{NewField(table, column)}
+}
+
+// WithTable returns a copy of f scoped to a different table name.
+func (f Bool) WithTable(table string) Bool {
+	f.Field = f.Field.WithTable(table)
+	return f
+}
+
+// Eq builds `column = value`.
+func (f Bool) Eq(value bool) Expr {
+	return clause.Eq{Column: f.Column(), Value: value}
+}
+
+// Not builds `column != true`, i.e. the logical negation of the column.
+func (f Bool) Not() Expr {
+	return clause.Neq{Column: f.Column(), Value: true}
+}
+
+// IsTrue builds `column = true`.
+func (f Bool) IsTrue() Expr {
+	return clause.Eq{Column: f.Column(), Value: true}
+}
+
+// IsFalse builds `column = false`.
+func (f Bool) IsFalse() Expr {
+	return clause.Eq{Column: f.Column(), Value: false}
+}
+
+// Set builds an assignment setting the column to value, for use with
+// Interface[T].Set.
+func (f Bool) Set(value bool) Assignment {
+	return clause.Assignment{Column: f.assignColumn(), Value: value}
+}
+
+// Toggle builds an assignment flipping the column's current value
+// (`column = NOT column`), for use with Interface[T].Set.
+func (f Bool) Toggle() Assignment {
+	return clause.Assignment{
+		Column: f.assignColumn(),
+		Value:  clause.Expr{SQL: "NOT ?", Vars: []interface{}{f.Column()}},
+	}
+}
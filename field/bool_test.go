@@ -0,0 +1,54 @@
+package field
+
+import (
+	"reflect"
+	"testing"
+
+	"gorm.io/gorm/clause"
+)
+
+func TestBoolPredicates(t *testing.T) {
+	f := NewBool("users", "active")
+
+	tests := []struct {
+		name string
+		expr Expr
+		want Expr
+	}{
+		{"Eq", f.Eq(true), clause.Eq{Column: f.Column(), Value: true}},
+		{"Not", f.Not(), clause.Neq{Column: f.Column(), Value: true}},
+		{"IsTrue", f.IsTrue(), clause.Eq{Column: f.Column(), Value: true}},
+		{"IsFalse", f.IsFalse(), clause.Eq{Column: f.Column(), Value: false}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.expr, tt.want) {
+				t.Errorf("got %#v, want %#v", tt.expr, tt.want)
+			}
+		})
+	}
+}
+
+func TestBoolAssignments(t *testing.T) {
+	f := NewBool("users", "active")
+
+	set := f.Set(false)
+	want := clause.Assignment{Column: clause.Column{Name: "active"}, Value: false}
+	if !reflect.DeepEqual(set, want) {
+		t.Errorf("Set: got %#v, want %#v", set, want)
+	}
+
+	toggle := f.Toggle()
+	wantCol := clause.Column{Name: "active"}
+	if toggle.Column != wantCol {
+		t.Errorf("Toggle: got column %#v, want %#v", toggle.Column, wantCol)
+	}
+	expr, ok := toggle.Value.(clause.Expr)
+	if !ok {
+		t.Fatalf("Toggle: value is %T, want clause.Expr", toggle.Value)
+	}
+	if expr.SQL != "NOT ?" {
+		t.Errorf("Toggle: got SQL %q, want %q", expr.SQL, "NOT ?")
+	}
+}
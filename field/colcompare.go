@@ -0,0 +1,41 @@
+package field
+
+import "gorm.io/gorm/clause"
+
+// Columner is implemented by every typed field in this package. The
+// *Col comparison helpers accept it so a column from one generated
+// model can be compared against a column from another (or itself) as
+// part of a join condition or intra-row predicate.
+type Columner interface {
+	Column() clause.Column
+}
+
+// EqCol builds `column = other`.
+func (f Field) EqCol(other Columner) Expr {
+	return clause.Eq{Column: f.Column(), Value: other.Column()}
+}
+
+// NeqCol builds `column <> other`.
+func (f Field) NeqCol(other Columner) Expr {
+	return clause.Neq{Column: f.Column(), Value: other.Column()}
+}
+
+// GtCol builds `column > other`.
+func (f Field) GtCol(other Columner) Expr {
+	return clause.Gt{Column: f.Column(), Value: other.Column()}
+}
+
+// GteCol builds `column >= other`.
+func (f Field) GteCol(other Columner) Expr {
+	return clause.Gte{Column: f.Column(), Value: other.Column()}
+}
+
+// LtCol builds `column < other`.
+func (f Field) LtCol(other Columner) Expr {
+	return clause.Lt{Column: f.Column(), Value: other.Column()}
+}
+
+// LteCol builds `column <= other`.
+func (f Field) LteCol(other Columner) Expr {
+	return clause.Lte{Column: f.Column(), Value: other.Column()}
+}
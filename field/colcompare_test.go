@@ -0,0 +1,19 @@
+package field
+
+import (
+	"reflect"
+	"testing"
+
+	"gorm.io/gorm/clause"
+)
+
+func TestColumnComparisons(t *testing.T) {
+	shippedAt := NewField("orders", "shipped_at")
+	createdAt := NewField("orders", "created_at")
+
+	got := shippedAt.GtCol(createdAt)
+	want := clause.Gt{Column: shippedAt.Column(), Value: createdAt.Column()}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GtCol: got %#v, want %#v", got, want)
+	}
+}
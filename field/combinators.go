@@ -0,0 +1,20 @@
+package field
+
+import "gorm.io/gorm/clause"
+
+// And groups exprs with explicit parenthesization, e.g.
+// `(a AND b)`, so nested boolean trees built from generated fields
+// compose predictably regardless of surrounding operators.
+func And(exprs ...Expr) Expr {
+	return clause.AndConditions{Exprs: exprs}
+}
+
+// Or groups exprs with explicit parenthesization, e.g. `(a OR b)`.
+func Or(exprs ...Expr) Expr {
+	return clause.OrConditions{Exprs: exprs}
+}
+
+// Not negates the grouped exprs, e.g. `NOT (a AND b)`.
+func Not(exprs ...Expr) Expr {
+	return clause.NotConditions{Exprs: exprs}
+}
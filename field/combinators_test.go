@@ -0,0 +1,26 @@
+package field
+
+import "testing"
+
+func TestCombinators(t *testing.T) {
+	active := NewBool("users", "active").IsTrue()
+	verified := NewBool("users", "verified").IsTrue()
+
+	var b fakeBuilder
+	And(active, verified).Build(&b)
+	if want := "(active = true AND verified = true)"; b.String() != want {
+		t.Errorf("And: got %q, want %q", b.String(), want)
+	}
+
+	b = fakeBuilder{}
+	Or(active, verified).Build(&b)
+	if want := "(active = true OR verified = true)"; b.String() != want {
+		t.Errorf("Or: got %q, want %q", b.String(), want)
+	}
+
+	b = fakeBuilder{}
+	Not(active).Build(&b)
+	if want := "active <> true"; b.String() != want {
+		t.Errorf("Not: got %q, want %q", b.String(), want)
+	}
+}
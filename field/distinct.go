@@ -0,0 +1,64 @@
+package field
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// IsDistinctFrom builds a null-safe `column IS DISTINCT FROM value`,
+// true whenever column and value differ, treating NULL as a comparable
+// value instead of propagating NULL the way `!=` does.
+func (f Field) IsDistinctFrom(value interface{}) Expr {
+	return distinctExpr{column: f.Column(), value: value, negate: true}
+}
+
+// IsNotDistinctFrom builds a null-safe `column IS NOT DISTINCT FROM
+// value`, the negation of IsDistinctFrom.
+func (f Field) IsNotDistinctFrom(value interface{}) Expr {
+	return distinctExpr{column: f.Column(), value: value, negate: false}
+}
+
+// Coalesce builds `COALESCE(column, fallback)`.
+func (f Field) Coalesce(fallback interface{}) Expr {
+	return clause.Expr{SQL: "COALESCE(?,?)", Vars: []interface{}{f.Column(), fallback}}
+}
+
+// distinctExpr renders a null-safe distinctness comparison. Most
+// dialects support the ANSI `IS DISTINCT FROM` form directly; MySQL
+// (before 8.0.19's CVE-free adoption in most deployments) lacks it, so
+// it falls back to the null-safe equality operator `<=>`.
+type distinctExpr struct {
+	column clause.Column
+	value  interface{}
+	negate bool // true => IS DISTINCT FROM, false => IS NOT DISTINCT FROM
+}
+
+func (e distinctExpr) Build(builder clause.Builder) {
+	if isMySQL(builder) {
+		if e.negate {
+			builder.WriteString("NOT (")
+			builder.WriteQuoted(e.column)
+			builder.WriteString(" <=> ")
+			builder.AddVar(builder, e.value)
+			builder.WriteByte(')')
+			return
+		}
+		builder.WriteQuoted(e.column)
+		builder.WriteString(" <=> ")
+		builder.AddVar(builder, e.value)
+		return
+	}
+
+	builder.WriteQuoted(e.column)
+	if e.negate {
+		builder.WriteString(" IS DISTINCT FROM ")
+	} else {
+		builder.WriteString(" IS NOT DISTINCT FROM ")
+	}
+	builder.AddVar(builder, e.value)
+}
+
+func isMySQL(builder clause.Builder) bool {
+	stmt, ok := builder.(*gorm.Statement)
+	return ok && stmt.Dialector != nil && stmt.Dialector.Name() == "mysql"
+}
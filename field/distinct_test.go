@@ -0,0 +1,79 @@
+package field
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"gorm.io/gorm/clause"
+)
+
+// fakeBuilder is a minimal clause.Builder good enough to assert on the
+// SQL shape these expressions render, without standing up a full
+// gorm.Statement/dialector.
+type fakeBuilder struct {
+	strings.Builder
+}
+
+func (b *fakeBuilder) WriteQuoted(field interface{}) {
+	if col, ok := field.(clause.Column); ok {
+		b.WriteString(col.Name)
+		return
+	}
+	fmt.Fprintf(b, "%v", field)
+}
+
+func (b *fakeBuilder) AddVar(w clause.Writer, vars ...interface{}) {
+	for i, v := range vars {
+		if i > 0 {
+			_, _ = w.WriteString(",")
+		}
+		if col, ok := v.(clause.Column); ok {
+			_, _ = w.WriteString(col.Name)
+			continue
+		}
+		if list, ok := v.([]interface{}); ok {
+			if len(list) == 0 {
+				_, _ = w.WriteString("(NULL)")
+				continue
+			}
+			_, _ = w.WriteString("(")
+			b.AddVar(w, list...)
+			_, _ = w.WriteString(")")
+			continue
+		}
+		_, _ = w.WriteString(fmt.Sprintf("%v", v))
+	}
+}
+
+func (b *fakeBuilder) AddError(err error) error { return err }
+
+func TestIsDistinctFrom(t *testing.T) {
+	f := NewField("users", "email")
+
+	var b fakeBuilder
+	f.IsDistinctFrom("a@b.com").(distinctExpr).Build(&b)
+	if got, want := b.String(), "email IS DISTINCT FROM a@b.com"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	b = fakeBuilder{}
+	f.IsNotDistinctFrom("a@b.com").(distinctExpr).Build(&b)
+	if got, want := b.String(), "email IS NOT DISTINCT FROM a@b.com"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestCoalesce(t *testing.T) {
+	f := NewField("users", "nickname")
+	expr, ok := f.Coalesce("anonymous").(clause.Expr)
+	if !ok {
+		t.Fatalf("Coalesce returned %T, want clause.Expr", f.Coalesce("anonymous"))
+	}
+	if expr.SQL != "COALESCE(?,?)" {
+		t.Errorf("got SQL %q", expr.SQL)
+	}
+	if len(expr.Vars) != 2 || expr.Vars[1] != "anonymous" {
+		t.Errorf("got Vars %#v", expr.Vars)
+	}
+}
@@ -0,0 +1,56 @@
+package field
+
+import "gorm.io/gorm/clause"
+
+// Encryptor encrypts and decrypts the plaintext behind an
+// EncryptedField. Encrypt must be deterministic - the same plaintext
+// always producing the same ciphertext - or Eq predicates built against
+// freshly encrypted values will never match rows written earlier.
+type Encryptor interface {
+	Encrypt(plaintext string) (string, error)
+	Decrypt(ciphertext string) (string, error)
+}
+
+// EncryptedField is a typed wrapper for a string column whose values
+// are encrypted at rest. Unlike the other typed fields, Eq and Set can
+// fail - encryption is - so both return an error alongside the usual
+// Expr/Assignment. Model struct fields still need their own serializer
+// wiring (see gen.RegisterEncryptedSerializer) to decrypt on scan; this
+// type only covers values gen builds into predicates and assignments.
+type EncryptedField struct {
+	Field
+	enc Encryptor
+}
+
+// NewEncryptedField constructs an EncryptedField for the given
+// table/column, using enc to encrypt values passed to Eq and Set.
+// Generated code calls this when a column is declared encrypted in
+// genconfig.
+func NewEncryptedField(table, column string, enc Encryptor) EncryptedField {
+	return EncryptedField{Field: NewField(table, column), enc: enc}
+}
+
+// WithTable returns a copy of f scoped to a different table name.
+func (f EncryptedField) WithTable(table string) EncryptedField {
+	f.Field = f.Field.WithTable(table)
+	return f
+}
+
+// Eq builds `column = value`, encrypting value first.
+func (f EncryptedField) Eq(value string) (Expr, error) {
+	ciphertext, err := f.enc.Encrypt(value)
+	if err != nil {
+		return nil, err
+	}
+	return clause.Eq{Column: f.Column(), Value: ciphertext}, nil
+}
+
+// Set builds an assignment setting the column to value, encrypting
+// value first, for use with Interface[T].Set.
+func (f EncryptedField) Set(value string) (Assignment, error) {
+	ciphertext, err := f.enc.Encrypt(value)
+	if err != nil {
+		return Assignment{}, err
+	}
+	return clause.Assignment{Column: f.assignColumn(), Value: ciphertext}, nil
+}
@@ -0,0 +1,66 @@
+package field
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"gorm.io/gorm/clause"
+)
+
+type reverseEncryptor struct{}
+
+func (reverseEncryptor) Encrypt(plaintext string) (string, error) {
+	runes := []rune(plaintext)
+	for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+		runes[i], runes[j] = runes[j], runes[i]
+	}
+	return string(runes), nil
+}
+
+func (reverseEncryptor) Decrypt(ciphertext string) (string, error) {
+	return reverseEncryptor{}.Encrypt(ciphertext)
+}
+
+type failingEncryptor struct{}
+
+func (failingEncryptor) Encrypt(string) (string, error) { return "", errors.New("boom") }
+func (failingEncryptor) Decrypt(string) (string, error) { return "", errors.New("boom") }
+
+func TestEncryptedFieldEq(t *testing.T) {
+	f := NewEncryptedField("users", "ssn", reverseEncryptor{})
+
+	expr, err := f.Eq("123-45-6789")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := clause.Eq{Column: f.Column(), Value: "9876-54-321"}
+	if !reflect.DeepEqual(expr, want) {
+		t.Errorf("got %#v, want %#v", expr, want)
+	}
+}
+
+func TestEncryptedFieldSet(t *testing.T) {
+	f := NewEncryptedField("users", "ssn", reverseEncryptor{})
+
+	assignment, err := f.Set("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := clause.Assignment{Column: clause.Column{Name: "ssn"}, Value: "terces"}
+	if !reflect.DeepEqual(assignment, want) {
+		t.Errorf("got %#v, want %#v", assignment, want)
+	}
+}
+
+func TestEncryptedFieldPropagatesEncryptorError(t *testing.T) {
+	f := NewEncryptedField("users", "ssn", failingEncryptor{})
+
+	if _, err := f.Eq("secret"); err == nil || !strings.Contains(err.Error(), "boom") {
+		t.Errorf("Eq err = %v, want it to surface the encryptor's error", err)
+	}
+	if _, err := f.Set("secret"); err == nil || !strings.Contains(err.Error(), "boom") {
+		t.Errorf("Set err = %v, want it to surface the encryptor's error", err)
+	}
+}
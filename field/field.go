@@ -0,0 +1,58 @@
+// Package field provides typed column wrappers used by generated query
+// code. Each generated model exposes one field value per column (Bool,
+// String, Int, Time, ...); the methods on those types build gorm clause
+// expressions so callers get compile-time checked predicates instead of
+// hand-written SQL fragments or stringly-typed column names.
+package field
+
+import "gorm.io/gorm/clause"
+
+// Expr is the predicate type returned by field comparison helpers (Eq,
+// Gt, Like, ...) and accepted by Interface[T].Where. It is a type alias
+// for clause.Expression so typed predicates compose directly with raw
+// gorm expressions and gorm.DB Scopes.
+type Expr = clause.Expression
+
+// Assignment is produced by Set-helpers (Toggle, SetCol, SetExpr, ...)
+// and accepted by Interface[T].Set/Updates. It is a type alias for
+// clause.Assignment for the same reason Expr aliases clause.Expression.
+type Assignment = clause.Assignment
+
+// Field is the common base embedded by every typed column wrapper. It
+// carries the table/column pair generated code resolves at codegen time.
+type Field struct {
+	table  string
+	column string
+}
+
+// NewField constructs the base Field for a table/column pair. Generated
+// code calls this (via the typed constructors in this package) when
+// building a model's field set; it is rarely used directly.
+func NewField(table, column string) Field {
+	return Field{table: table, column: column}
+}
+
+// Column returns the clause.Column this field refers to.
+func (f Field) Column() clause.Column {
+	return clause.Column{Table: f.table, Name: f.column}
+}
+
+// ColumnName returns the unqualified column name.
+func (f Field) ColumnName() string { return f.column }
+
+// assignColumn returns the unqualified clause.Column Set-helpers target:
+// an UPDATE/INSERT SET clause names its column bare, never table-qualified.
+func (f Field) assignColumn() clause.Column {
+	return clause.Column{Name: f.column}
+}
+
+// TableName returns the table this field was generated for.
+func (f Field) TableName() string { return f.table }
+
+// WithTable returns a copy of f scoped to a different table name,
+// useful for self-joins and aliased subqueries where the same column
+// needs to refer to two different table references.
+func (f Field) WithTable(table string) Field {
+	f.table = table
+	return f
+}
@@ -0,0 +1,37 @@
+package field
+
+import "gorm.io/gorm/clause"
+
+// Geometry wraps a PostGIS geometry/geography column.
+type Geometry struct {
+	Field
+}
+
+// NewGeometry constructs a Geometry field for the given table/column.
+func NewGeometry(table, column string) Geometry {
+	return Geometry{NewField(table, column)}
+}
+
+// WithTable returns a copy of f scoped to a different table name.
+func (f Geometry) WithTable(table string) Geometry {
+	f.Field = f.Field.WithTable(table)
+	return f
+}
+
+// STDistance builds `ST_Distance(column, other)`, the planar/spheroid
+// distance between the column's geometry and other.
+func (f Geometry) STDistance(other interface{}) Expr {
+	return clause.Expr{SQL: "ST_Distance(?,?)", Vars: []interface{}{f.Column(), other}}
+}
+
+// STWithin builds `ST_Within(column, geom)`, true when the column's
+// geometry lies entirely within geom.
+func (f Geometry) STWithin(geom interface{}) Expr {
+	return clause.Expr{SQL: "ST_Within(?,?)", Vars: []interface{}{f.Column(), geom}}
+}
+
+// STIntersects builds `ST_Intersects(column, geom)`, true when the
+// column's geometry shares any point with geom.
+func (f Geometry) STIntersects(geom interface{}) Expr {
+	return clause.Expr{SQL: "ST_Intersects(?,?)", Vars: []interface{}{f.Column(), geom}}
+}
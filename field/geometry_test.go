@@ -0,0 +1,36 @@
+package field
+
+import (
+	"testing"
+
+	"gorm.io/gorm/clause"
+)
+
+func TestGeometryHelpers(t *testing.T) {
+	f := NewGeometry("stores", "location")
+
+	cases := []struct {
+		name string
+		expr Expr
+		sql  string
+	}{
+		{"STDistance", f.STDistance("POINT(1 1)"), "ST_Distance(?,?)"},
+		{"STWithin", f.STWithin("POLYGON(...)"), "ST_Within(?,?)"},
+		{"STIntersects", f.STIntersects("POLYGON(...)"), "ST_Intersects(?,?)"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			e, ok := c.expr.(clause.Expr)
+			if !ok {
+				t.Fatalf("got %T, want clause.Expr", c.expr)
+			}
+			if e.SQL != c.sql {
+				t.Errorf("got SQL %q, want %q", e.SQL, c.sql)
+			}
+			if len(e.Vars) != 2 || e.Vars[0] != f.Column() {
+				t.Errorf("got Vars %#v", e.Vars)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package field
+
+import "gorm.io/gorm/clause"
+
+// groupExpr is the Columner implementation behind GroupExpr, a raw SQL
+// grouping term rather than a plain column.
+type groupExpr string
+
+func (g groupExpr) Column() clause.Column {
+	return clause.Column{Name: string(g), Raw: true}
+}
+
+// GroupExpr groups by a raw SQL expression - date truncation, JSON
+// extraction, a CASE statement - rather than a plain column, for
+// GroupBy terms the typed field helpers don't have a dedicated
+// constructor for. sql is written verbatim, so it must not come from
+// untrusted input.
+func GroupExpr(sql string) Columner {
+	return groupExpr(sql)
+}
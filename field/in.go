@@ -0,0 +1,10 @@
+package field
+
+import "gorm.io/gorm/clause"
+
+// In builds `column IN (values...)`. Pass scalar values for a literal
+// list, or a single *gorm.DB (e.g. from Interface[T].AsSubquery's
+// UnderlyingDB) for an IN-subquery.
+func (f Field) In(values ...interface{}) Expr {
+	return clause.Expr{SQL: "? IN ?", Vars: []interface{}{f.Column(), values}}
+}
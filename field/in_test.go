@@ -0,0 +1,27 @@
+package field
+
+import "testing"
+
+func TestInLiteralValues(t *testing.T) {
+	status := NewField("orders", "status")
+
+	expr := status.In("open", "pending")
+
+	var b fakeBuilder
+	expr.Build(&b)
+	if want := "status IN (open,pending)"; b.String() != want {
+		t.Errorf("got %q, want %q", b.String(), want)
+	}
+}
+
+func TestInNoValues(t *testing.T) {
+	status := NewField("orders", "status")
+
+	expr := status.In()
+
+	var b fakeBuilder
+	expr.Build(&b)
+	if want := "status IN (NULL)"; b.String() != want {
+		t.Errorf("got %q, want %q", b.String(), want)
+	}
+}
@@ -0,0 +1,31 @@
+package field
+
+import "gorm.io/gorm/clause"
+
+// Inet wraps a Postgres inet/cidr column.
+type Inet struct {
+	Field
+}
+
+// NewInet constructs an Inet field for the given table/column.
+func NewInet(table, column string) Inet {
+	return Inet{NewField(table, column)}
+}
+
+// WithTable returns a copy of f scoped to a different table name.
+func (f Inet) WithTable(table string) Inet {
+	f.Field = f.Field.WithTable(table)
+	return f
+}
+
+// ContainedBy builds `column << cidr`, true when the column's address
+// falls inside the given network.
+func (f Inet) ContainedBy(cidr string) Expr {
+	return clause.Expr{SQL: "? << ?", Vars: []interface{}{f.Column(), cidr}}
+}
+
+// Contains builds `column >> ip`, true when the column's network
+// contains the given address.
+func (f Inet) Contains(ip string) Expr {
+	return clause.Expr{SQL: "? >> ?", Vars: []interface{}{f.Column(), ip}}
+}
@@ -0,0 +1,19 @@
+package field
+
+import "testing"
+
+func TestInetHelpers(t *testing.T) {
+	f := NewInet("access_logs", "client_addr")
+
+	var b fakeBuilder
+	f.ContainedBy("10.0.0.0/8").Build(&b)
+	if want := "client_addr << 10.0.0.0/8"; b.String() != want {
+		t.Errorf("got %q, want %q", b.String(), want)
+	}
+
+	b = fakeBuilder{}
+	f.Contains("10.0.0.1").Build(&b)
+	if want := "client_addr >> 10.0.0.1"; b.String() != want {
+		t.Errorf("got %q, want %q", b.String(), want)
+	}
+}
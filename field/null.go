@@ -0,0 +1,60 @@
+package field
+
+import (
+	"database/sql"
+
+	"gorm.io/gorm/clause"
+)
+
+// Null wraps a nullable column of underlying type T, used by generated
+// code for pointer and sql.Null* model fields. It layers null-aware
+// helpers on top of the base comparisons so "no value" stays distinct
+// from "zero value" in generated queries.
+type Null[T any] struct {
+	Field
+}
+
+// NewNull constructs a Null[T] field for the given table/column.
+func NewNull[T any](table, column string) Null[T] {
+	return Null[T]{NewField(table, column)}
+}
+
+// WithTable returns a copy of f scoped to a different table name.
+func (f Null[T]) WithTable(table string) Null[T] {
+	f.Field = f.Field.WithTable(table)
+	return f
+}
+
+// IsNull builds `column IS NULL`.
+func (f Null[T]) IsNull() Expr {
+	return clause.Eq{Column: f.Column(), Value: nil}
+}
+
+// IsNotNull builds `column IS NOT NULL`.
+func (f Null[T]) IsNotNull() Expr {
+	return clause.Neq{Column: f.Column(), Value: nil}
+}
+
+// Eq builds `column = value`.
+func (f Null[T]) Eq(value T) Expr {
+	return clause.Eq{Column: f.Column(), Value: value}
+}
+
+// EqNullable builds `column = value.V` when value is valid, or
+// IsNull() when it isn't, matching sql.Null[T]'s own null semantics.
+func (f Null[T]) EqNullable(value sql.Null[T]) Expr {
+	if !value.Valid {
+		return f.IsNull()
+	}
+	return f.Eq(value.V)
+}
+
+// Set builds an assignment setting the column to value, for use with Interface[T].Set.
+func (f Null[T]) Set(value T) Assignment {
+	return clause.Assignment{Column: f.assignColumn(), Value: value}
+}
+
+// SetNull builds an assignment setting the column to NULL, for use with Interface[T].Set.
+func (f Null[T]) SetNull() Assignment {
+	return clause.Assignment{Column: f.assignColumn(), Value: nil}
+}
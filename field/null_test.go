@@ -0,0 +1,52 @@
+package field
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	"gorm.io/gorm/clause"
+)
+
+func TestNullPredicates(t *testing.T) {
+	f := NewNull[string]("users", "middle_name")
+
+	gotNull := f.IsNull()
+	wantNull := clause.Eq{Column: f.Column(), Value: nil}
+	if !reflect.DeepEqual(gotNull, wantNull) {
+		t.Errorf("IsNull: got %#v, want %#v", gotNull, wantNull)
+	}
+
+	gotNotNull := f.IsNotNull()
+	wantNotNull := clause.Neq{Column: f.Column(), Value: nil}
+	if !reflect.DeepEqual(gotNotNull, wantNotNull) {
+		t.Errorf("IsNotNull: got %#v, want %#v", gotNotNull, wantNotNull)
+	}
+}
+
+func TestNullEqNullable(t *testing.T) {
+	f := NewNull[string]("users", "middle_name")
+
+	valid := sql.Null[string]{V: "Danger", Valid: true}
+	gotValid := f.EqNullable(valid)
+	wantValid := f.Eq("Danger")
+	if !reflect.DeepEqual(gotValid, wantValid) {
+		t.Errorf("EqNullable(valid): got %#v, want %#v", gotValid, wantValid)
+	}
+
+	invalid := sql.Null[string]{}
+	gotInvalid := f.EqNullable(invalid)
+	wantInvalid := f.IsNull()
+	if !reflect.DeepEqual(gotInvalid, wantInvalid) {
+		t.Errorf("EqNullable(invalid): got %#v, want %#v", gotInvalid, wantInvalid)
+	}
+}
+
+func TestNullSetNull(t *testing.T) {
+	f := NewNull[string]("users", "middle_name")
+	got := f.SetNull()
+	want := clause.Assignment{Column: clause.Column{Name: "middle_name"}, Value: nil}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SetNull: got %#v, want %#v", got, want)
+	}
+}
@@ -0,0 +1,76 @@
+package field
+
+import "gorm.io/gorm/clause"
+
+// OrderableInterface is implemented by the values field.Asc/Desc (and
+// their NullsFirst/NullsLast refinements) produce, and is the type
+// Interface[T].OrderBy accepts. It keeps ORDER BY out of stringly-typed
+// territory.
+type OrderableInterface interface {
+	// OrderExpr returns the clause.Expression gorm renders for this term.
+	OrderExpr() clause.Expression
+	// NullsFirst reorders NULL values to sort before non-NULL values.
+	NullsFirst() OrderableInterface
+	// NullsLast reorders NULL values to sort after non-NULL values.
+	NullsLast() OrderableInterface
+}
+
+type nullsOrder int
+
+const (
+	nullsDefault nullsOrder = iota
+	nullsFirst
+	nullsLast
+)
+
+// orderExpr is the default OrderableInterface implementation.
+type orderExpr struct {
+	column    clause.Column
+	desc      bool
+	nulls     nullsOrder
+	collation string
+}
+
+func (o orderExpr) OrderExpr() clause.Expression { return o }
+
+func (o orderExpr) NullsFirst() OrderableInterface {
+	o.nulls = nullsFirst
+	return o
+}
+
+func (o orderExpr) NullsLast() OrderableInterface {
+	o.nulls = nullsLast
+	return o
+}
+
+// Build renders the term, emulating NULLS FIRST/LAST with a leading
+// CASE WHEN ... IS NULL tiebreaker since MySQL has no native syntax for it.
+func (o orderExpr) Build(builder clause.Builder) {
+	switch o.nulls {
+	case nullsFirst:
+		builder.WriteString("CASE WHEN ")
+		builder.WriteQuoted(o.column)
+		builder.WriteString(" IS NULL THEN 0 ELSE 1 END, ")
+	case nullsLast:
+		builder.WriteString("CASE WHEN ")
+		builder.WriteQuoted(o.column)
+		builder.WriteString(" IS NULL THEN 1 ELSE 0 END, ")
+	}
+	builder.WriteQuoted(o.column)
+	if o.collation != "" {
+		builder.WriteString(" COLLATE " + o.collation)
+	}
+	if o.desc {
+		builder.WriteString(" DESC")
+	}
+}
+
+// Asc builds an ascending ORDER BY term for the field.
+func (f Field) Asc() OrderableInterface {
+	return orderExpr{column: f.Column()}
+}
+
+// Desc builds a descending ORDER BY term for the field.
+func (f Field) Desc() OrderableInterface {
+	return orderExpr{column: f.Column(), desc: true}
+}
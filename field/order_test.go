@@ -0,0 +1,34 @@
+package field
+
+import "testing"
+
+func TestOrderTerms(t *testing.T) {
+	f := NewField("users", "last_seen_at")
+
+	cases := []struct {
+		name string
+		term OrderableInterface
+		want string
+	}{
+		{"Asc", f.Asc(), "last_seen_at"},
+		{"Desc", f.Desc(), "last_seen_at DESC"},
+		{
+			"DescNullsLast", f.Desc().NullsLast(),
+			"CASE WHEN last_seen_at IS NULL THEN 1 ELSE 0 END, last_seen_at DESC",
+		},
+		{
+			"AscNullsFirst", f.Asc().NullsFirst(),
+			"CASE WHEN last_seen_at IS NULL THEN 0 ELSE 1 END, last_seen_at",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var b fakeBuilder
+			c.term.OrderExpr().(orderExpr).Build(&b)
+			if b.String() != c.want {
+				t.Errorf("got %q, want %q", b.String(), c.want)
+			}
+		})
+	}
+}
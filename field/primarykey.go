@@ -0,0 +1,30 @@
+package field
+
+import "gorm.io/gorm/clause"
+
+// KeyValue pairs a primary-key column with the value identifying one
+// row of it, for PrimaryKey's composite-key equality predicate.
+type KeyValue struct {
+	Column Columner
+	Value  interface{}
+}
+
+// PrimaryKey builds an equality predicate across every column of a
+// (possibly composite) primary key, ANDed together, for typed
+// delete/update-by-key on a table whose key has more than one column,
+// e.g. an order_lines table keyed on (order_id, line_no):
+//
+//	q.Where(field.PrimaryKey(
+//	    field.KeyValue{Column: OrderLinesOrderId, Value: 7},
+//	    field.KeyValue{Column: OrderLinesLineNo, Value: 2},
+//	)).Delete()
+//
+// A single KeyValue works the same way for an ordinary single-column
+// key.
+func PrimaryKey(key ...KeyValue) Expr {
+	exprs := make([]Expr, len(key))
+	for i, kv := range key {
+		exprs[i] = clause.Eq{Column: kv.Column.Column(), Value: kv.Value}
+	}
+	return And(exprs...)
+}
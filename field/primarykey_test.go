@@ -0,0 +1,27 @@
+package field
+
+import "testing"
+
+func TestPrimaryKeySingleColumn(t *testing.T) {
+	id := NewNull[int64]("users", "id")
+
+	var b fakeBuilder
+	PrimaryKey(KeyValue{Column: id, Value: int64(7)}).Build(&b)
+	if want := "id = 7"; b.String() != want {
+		t.Errorf("PrimaryKey: got %q, want %q", b.String(), want)
+	}
+}
+
+func TestPrimaryKeyComposite(t *testing.T) {
+	orderID := NewNull[int64]("order_lines", "order_id")
+	lineNo := NewNull[int64]("order_lines", "line_no")
+
+	var b fakeBuilder
+	PrimaryKey(
+		KeyValue{Column: orderID, Value: int64(7)},
+		KeyValue{Column: lineNo, Value: int64(2)},
+	).Build(&b)
+	if want := "(order_id = 7 AND line_no = 2)"; b.String() != want {
+		t.Errorf("PrimaryKey: got %q, want %q", b.String(), want)
+	}
+}
@@ -0,0 +1,37 @@
+package field
+
+import "gorm.io/gorm/clause"
+
+// RawExpr is a typed raw SQL fragment produced by ExprOf. The type
+// parameter T documents (and, via Into, recovers) the Go type the
+// fragment evaluates to when used as a Select projection; RawExpr
+// otherwise behaves exactly like any other Expr.
+type RawExpr[T any] struct {
+	clause.Expr
+}
+
+// ExprOf builds a typed raw SQL expression for the rare case the
+// typed API can't express a predicate or projection directly. Any
+// generated field passed in args is bound as a quoted column
+// reference rather than a parameter, so raw fragments still get
+// column-name safety; everything else is bound as a parameter. The
+// result flows through Select/Where/Having like any other Expr.
+func ExprOf[T any](sql string, args ...interface{}) RawExpr[T] {
+	vars := make([]interface{}, len(args))
+	for i, arg := range args {
+		if col, ok := arg.(Columner); ok {
+			vars[i] = col.Column()
+			continue
+		}
+		vars[i] = arg
+	}
+	return RawExpr[T]{clause.Expr{SQL: sql, Vars: vars}}
+}
+
+// Into reports the Go type T this expression is expected to scan into.
+// It exists purely for documentation/reflection; it never has a useful
+// runtime value.
+func (RawExpr[T]) Into() T {
+	var zero T
+	return zero
+}
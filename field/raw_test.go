@@ -0,0 +1,28 @@
+package field
+
+import "testing"
+
+func TestExprOfBindsColumns(t *testing.T) {
+	price := NewField("orders", "price")
+	tax := NewField("orders", "tax")
+
+	expr := ExprOf[float64]("? + ?", price, tax)
+
+	var b fakeBuilder
+	expr.Build(&b)
+	if want := "price + tax"; b.String() != want {
+		t.Errorf("got %q, want %q", b.String(), want)
+	}
+}
+
+func TestExprOfBindsPlainValues(t *testing.T) {
+	price := NewField("orders", "price")
+
+	expr := ExprOf[bool]("? > ?", price, 100)
+
+	var b fakeBuilder
+	expr.Build(&b)
+	if want := "price > 100"; b.String() != want {
+		t.Errorf("got %q, want %q", b.String(), want)
+	}
+}
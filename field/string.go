@@ -0,0 +1,63 @@
+package field
+
+import "gorm.io/gorm/clause"
+
+// String is a typed wrapper for text columns.
+type String struct {
+	Field
+	collation string
+}
+
+// NewString constructs a String field for the given table/column.
+func NewString(table, column string) String {
+	return String{Field: NewField(table, column)}
+}
+
+// WithTable returns a copy of f scoped to a different table name,
+// preserving any collation set via Collate.
+func (f String) WithTable(table string) String {
+	f.Field = f.Field.WithTable(table)
+	return f
+}
+
+// Collate returns a copy of f whose comparisons and ORDER BY terms
+// specify the given collation (e.g. "utf8mb4_bin"), instead of falling
+// back to raw SQL to pin one.
+func (f String) Collate(name string) String {
+	f.collation = name
+	return f
+}
+
+// Eq builds `column = value`, applying the field's collation if one was set via Collate.
+func (f String) Eq(value string) Expr {
+	if f.collation == "" {
+		return clause.Eq{Column: f.Column(), Value: value}
+	}
+	return clause.Expr{SQL: "? COLLATE " + f.collation + " = ?", Vars: []interface{}{f.Column(), value}}
+}
+
+// Neq builds `column <> value`, applying the field's collation if one was set via Collate.
+func (f String) Neq(value string) Expr {
+	if f.collation == "" {
+		return clause.Neq{Column: f.Column(), Value: value}
+	}
+	return clause.Expr{SQL: "? COLLATE " + f.collation + " <> ?", Vars: []interface{}{f.Column(), value}}
+}
+
+// Like builds `column LIKE pattern`, applying the field's collation if one was set via Collate.
+func (f String) Like(pattern string) Expr {
+	if f.collation == "" {
+		return clause.Like{Column: f.Column(), Value: pattern}
+	}
+	return clause.Expr{SQL: "? COLLATE " + f.collation + " LIKE ?", Vars: []interface{}{f.Column(), pattern}}
+}
+
+// Asc builds an ascending ORDER BY term, applying the field's collation if one was set via Collate.
+func (f String) Asc() OrderableInterface {
+	return orderExpr{column: f.Column(), collation: f.collation}
+}
+
+// Desc builds a descending ORDER BY term, applying the field's collation if one was set via Collate.
+func (f String) Desc() OrderableInterface {
+	return orderExpr{column: f.Column(), desc: true, collation: f.collation}
+}
@@ -0,0 +1,37 @@
+package field
+
+import (
+	"reflect"
+	"testing"
+
+	"gorm.io/gorm/clause"
+)
+
+func TestStringEqWithoutCollation(t *testing.T) {
+	f := NewString("users", "username")
+	got := f.Eq("bob")
+	want := clause.Eq{Column: f.Column(), Value: "bob"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %#v, want %#v", got, want)
+	}
+}
+
+func TestStringEqWithCollation(t *testing.T) {
+	f := NewString("users", "username").Collate("utf8mb4_bin")
+
+	var b fakeBuilder
+	f.Eq("bob").Build(&b)
+	if want := "username COLLATE utf8mb4_bin = bob"; b.String() != want {
+		t.Errorf("got %q, want %q", b.String(), want)
+	}
+}
+
+func TestStringOrderWithCollation(t *testing.T) {
+	f := NewString("users", "username").Collate("utf8mb4_bin")
+
+	var b fakeBuilder
+	f.Desc().OrderExpr().Build(&b)
+	if want := "username COLLATE utf8mb4_bin DESC"; b.String() != want {
+		t.Errorf("got %q, want %q", b.String(), want)
+	}
+}
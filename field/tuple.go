@@ -0,0 +1,72 @@
+package field
+
+import (
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm/clause"
+)
+
+// Tuple groups several columns for row comparisons used in keyset
+// pagination, e.g. `field.Tuple(User.CreatedAt, User.ID).Gt(createdAt, id)`
+// for `... WHERE (created_at, id) > (?, ?)`.
+type Tuple struct {
+	columns []clause.Column
+}
+
+// NewTuple groups cols into a Tuple in the given order. Use the
+// generated field.Tuple helper rather than calling this directly.
+func NewTuple(cols ...Columner) Tuple {
+	columns := make([]clause.Column, len(cols))
+	for i, c := range cols {
+		columns[i] = c.Column()
+	}
+	return Tuple{columns: columns}
+}
+
+// Gt builds a tuple greater-than comparison.
+func (t Tuple) Gt(values ...interface{}) Expr { return t.compare(values, ">", ">") }
+
+// Gte builds a tuple greater-than-or-equal comparison.
+func (t Tuple) Gte(values ...interface{}) Expr { return t.compare(values, ">", ">=") }
+
+// Lt builds a tuple less-than comparison.
+func (t Tuple) Lt(values ...interface{}) Expr { return t.compare(values, "<", "<") }
+
+// Lte builds a tuple less-than-or-equal comparison.
+func (t Tuple) Lte(values ...interface{}) Expr { return t.compare(values, "<", "<=") }
+
+// compare expands the row comparison into the equivalent OR-of-ANDs
+// lexicographic form (`a > x OR (a = x AND b > y) OR ...`) instead of
+// emitting a native row constructor, so the same SQL runs unmodified
+// on dialects that don't support `(a, b) > (?, ?)` predicates, notably
+// older MySQL.
+func (t Tuple) compare(values []interface{}, strictOp, finalOp string) Expr {
+	if len(values) != len(t.columns) {
+		panic(fmt.Sprintf("field: Tuple has %d columns but got %d values", len(t.columns), len(values)))
+	}
+
+	var sql strings.Builder
+	vars := make([]interface{}, 0, len(t.columns)*(len(t.columns)+1)/2*2)
+
+	n := len(t.columns)
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			sql.WriteString(" OR ")
+		}
+		sql.WriteByte('(')
+		for j := 0; j < i; j++ {
+			sql.WriteString("? = ? AND ")
+			vars = append(vars, t.columns[j], values[j])
+		}
+		op := strictOp
+		if i == n-1 {
+			op = finalOp
+		}
+		sql.WriteString("? " + op + " ?")
+		vars = append(vars, t.columns[i], values[i])
+		sql.WriteByte(')')
+	}
+
+	return clause.Expr{SQL: sql.String(), Vars: vars}
+}
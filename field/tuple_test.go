@@ -0,0 +1,26 @@
+package field
+
+import "testing"
+
+func TestTupleGt(t *testing.T) {
+	createdAt := NewField("orders", "created_at")
+	id := NewField("orders", "id")
+
+	expr := NewTuple(createdAt, id).Gt("2024-01-01", 42)
+
+	var b fakeBuilder
+	expr.Build(&b)
+	want := "(created_at > 2024-01-01) OR (created_at = 2024-01-01 AND id > 42)"
+	if b.String() != want {
+		t.Errorf("got %q, want %q", b.String(), want)
+	}
+}
+
+func TestTupleLengthMismatchPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic on mismatched value count")
+		}
+	}()
+	NewTuple(NewField("orders", "id")).Gt(1, 2)
+}
@@ -0,0 +1,110 @@
+package gen
+
+import (
+	"context"
+	"reflect"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/go-gorm/cli/field"
+)
+
+// Principal resolves the current actor from ctx, for WithAuditColumns
+// to stamp onto created_by/updated_by columns. ok is false when no
+// principal is available (e.g. an unauthenticated background job), in
+// which case the column is left alone.
+type Principal func(ctx context.Context) (value interface{}, ok bool)
+
+// AuditAssignment builds an assignment stamping col with value, for
+// staging an audit column onto Interface[T].Set by hand alongside
+// WithAuditColumns' automatic stamping.
+func AuditAssignment(col field.Columner, value interface{}) field.Assignment {
+	return clause.Assignment{Column: col.Column(), Value: value}
+}
+
+// WithAuditColumns registers a hook on db that stamps createdByColumn
+// on every Create, and updatedByColumn on every Create and Update,
+// with the value resolve returns for the operation's context. A
+// column already given an explicit value - a struct field set before
+// Create, or a column already staged via Set before Update - is left
+// alone. Pass "" for either column to skip stamping it.
+func WithAuditColumns(db *gorm.DB, createdByColumn, updatedByColumn string, resolve Principal) error {
+	stampOnCreate := func(column string) func(*gorm.DB) {
+		return func(tx *gorm.DB) {
+			if column == "" || structColumnAlreadySet(tx.Statement, column) {
+				return
+			}
+			if principal, ok := resolve(tx.Statement.Context); ok {
+				tx.Statement.SetColumn(column, principal)
+			}
+		}
+	}
+	stampOnUpdate := func(column string) func(*gorm.DB) {
+		return func(tx *gorm.DB) {
+			if column == "" || setColumnAlreadyStaged(tx.Statement, column) {
+				return
+			}
+			if principal, ok := resolve(tx.Statement.Context); ok {
+				stageSetAssignment(tx.Statement, column, principal)
+			}
+		}
+	}
+
+	if err := db.Callback().Create().Before("gorm:create").Register("gen:audit_created_by", stampOnCreate(createdByColumn)); err != nil {
+		return err
+	}
+	if err := db.Callback().Create().Before("gorm:create").Register("gen:audit_updated_by_on_create", stampOnCreate(updatedByColumn)); err != nil {
+		return err
+	}
+	if err := db.Callback().Update().Before("gorm:before_update").Register("gen:audit_updated_by_on_update", stampOnUpdate(updatedByColumn)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// structColumnAlreadySet reports whether stmt's struct destination
+// already has a non-zero value for column.
+func structColumnAlreadySet(stmt *gorm.Statement, column string) bool {
+	if stmt.Schema == nil {
+		return false
+	}
+	f := stmt.Schema.LookUpField(column)
+	if f == nil {
+		return false
+	}
+	destValue := reflect.ValueOf(stmt.Dest)
+	for destValue.Kind() == reflect.Ptr {
+		destValue = destValue.Elem()
+	}
+	if destValue.Kind() != reflect.Struct {
+		return false
+	}
+	_, zero := f.ValueOf(stmt.Context, destValue)
+	return !zero
+}
+
+// setColumnAlreadyStaged reports whether stmt's SET clause already has
+// an assignment for column.
+func setColumnAlreadyStaged(stmt *gorm.Statement, column string) bool {
+	set, ok := stmt.Clauses["SET"].Expression.(clause.Set)
+	if !ok {
+		return false
+	}
+	for _, assignment := range set {
+		if assignment.Column.Name == column {
+			return true
+		}
+	}
+	return false
+}
+
+// stageSetAssignment appends an assignment for column to stmt's SET
+// clause, preserving whatever was already staged.
+func stageSetAssignment(stmt *gorm.Statement, column string, value interface{}) {
+	c := stmt.Clauses["SET"]
+	set, _ := c.Expression.(clause.Set)
+	c.Name = "SET"
+	c.Expression = append(set, clause.Assignment{Column: clause.Column{Name: column}, Value: value})
+	stmt.Clauses["SET"] = c
+}
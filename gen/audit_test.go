@@ -0,0 +1,102 @@
+package gen
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-gorm/cli/field"
+)
+
+type auditedModel struct {
+	ID        uint
+	Name      string
+	CreatedBy string
+	UpdatedBy string
+}
+
+type principalKey struct{}
+
+func principalFromContext(ctx context.Context) (interface{}, bool) {
+	v := ctx.Value(principalKey{})
+	if v == nil {
+		return nil, false
+	}
+	return v, true
+}
+
+func nameEqAudited(name string) field.Expr {
+	return field.NewString("audited_models", "name").Eq(name)
+}
+
+func TestWithAuditColumnsStampsCreate(t *testing.T) {
+	db := newTestDB(t)
+	if err := db.AutoMigrate(&auditedModel{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := WithAuditColumns(db, "created_by", "updated_by", principalFromContext); err != nil {
+		t.Fatal(err)
+	}
+
+	q := Use[auditedModel](db)
+	ctx := context.WithValue(context.Background(), principalKey{}, "alice")
+	m := &auditedModel{Name: "widget"}
+	if err := q.WithContext(ctx).Create(m); err != nil {
+		t.Fatal(err)
+	}
+	if m.CreatedBy != "alice" || m.UpdatedBy != "alice" {
+		t.Errorf("CreatedBy = %q, UpdatedBy = %q, want both alice", m.CreatedBy, m.UpdatedBy)
+	}
+}
+
+func TestWithAuditColumnsDoesNotOverrideExplicitValue(t *testing.T) {
+	db := newTestDB(t)
+	if err := db.AutoMigrate(&auditedModel{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := WithAuditColumns(db, "created_by", "updated_by", principalFromContext); err != nil {
+		t.Fatal(err)
+	}
+
+	q := Use[auditedModel](db)
+	ctx := context.WithValue(context.Background(), principalKey{}, "alice")
+	m := &auditedModel{Name: "widget", CreatedBy: "bob"}
+	if err := q.WithContext(ctx).Create(m); err != nil {
+		t.Fatal(err)
+	}
+	if m.CreatedBy != "bob" {
+		t.Errorf("CreatedBy = %q, want bob to survive unchanged", m.CreatedBy)
+	}
+}
+
+func TestWithAuditColumnsStampsUpdate(t *testing.T) {
+	db := newTestDB(t)
+	if err := db.AutoMigrate(&auditedModel{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := WithAuditColumns(db, "created_by", "updated_by", principalFromContext); err != nil {
+		t.Fatal(err)
+	}
+
+	q := Use[auditedModel](db)
+	createCtx := context.WithValue(context.Background(), principalKey{}, "alice")
+	m := &auditedModel{Name: "widget"}
+	if err := q.WithContext(createCtx).Create(m); err != nil {
+		t.Fatal(err)
+	}
+
+	updateCtx := context.WithValue(context.Background(), principalKey{}, "carol")
+	if _, err := q.WithContext(updateCtx).Where(nameEqAudited("widget")).Updates(); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := q.Where(nameEqAudited("widget")).First()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.UpdatedBy != "carol" {
+		t.Errorf("UpdatedBy = %q, want carol", result.UpdatedBy)
+	}
+	if result.CreatedBy != "alice" {
+		t.Errorf("CreatedBy = %q, want alice to survive the update unchanged", result.CreatedBy)
+	}
+}
@@ -0,0 +1,51 @@
+package gen
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-gorm/cli/field"
+)
+
+func TestCreateInBatches(t *testing.T) {
+	db := newTestDB(t)
+	q := Use[testModel](db)
+
+	records := []*testModel{{Name: "a"}, {Name: "b"}, {Name: "c"}, {Name: "d"}, {Name: "e"}}
+	if err := q.CreateInBatches(context.Background(), records, 2); err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := q.Count()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 5 {
+		t.Errorf("count = %d, want 5", count)
+	}
+}
+
+func TestCreateInBatchesWithOnConflict(t *testing.T) {
+	db := newTestDB(t)
+	q := Use[testModel](db)
+	if err := q.Create(&testModel{ID: 1, Name: "old"}); err != nil {
+		t.Fatal(err)
+	}
+
+	id := field.NewField("test_models", "id")
+	updated := field.NewField("test_models", "name").SetExpr(field.ExprOf[string]("?", "new"))
+	records := []*testModel{{ID: 1, Name: "new"}, {ID: 2, Name: "fresh"}}
+
+	err := Use[testModel](db).OnConflict(id).DoUpdate(updated).CreateInBatches(context.Background(), records, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := Use[testModel](db).Find()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("len(got) = %d, want 2", len(got))
+	}
+}
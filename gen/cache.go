@@ -0,0 +1,243 @@
+package gen
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/go-gorm/cli/field"
+)
+
+// Cache is the pluggable store a caching decorator reads through on a
+// cache hit and populates on a miss. Implementations are free to back
+// it with anything - an in-process LRU, Redis, memcached - WithCache
+// only needs Get/Set.
+type Cache interface {
+	// Get returns the cached value for key and whether it was found.
+	Get(ctx context.Context, key string) (value any, ok bool)
+	// Set stores value under key for ttl.
+	Set(ctx context.Context, key string, value any, ttl time.Duration)
+}
+
+// cached decorates an Interface[T], caching its read-only methods
+// (Find, First, Take, Count, CountOf, CountDistinctOf, Exists) behind
+// Cache for ttl, keyed by the chain's SQL. Every other method
+// delegates to the embedded Interface[T] unmodified. It does not
+// invalidate the cache on a write through the same chain - callers
+// mixing reads and writes on one cached decorator should pick a ttl
+// short enough to tolerate that, or use a Cache whose Set can be
+// invalidated out of band.
+type cached[T any] struct {
+	Interface[T]
+	cache Cache
+	ttl   time.Duration
+}
+
+// WithCache decorates inner so Find, First, Take, Count, CountOf,
+// CountDistinctOf, and Exists are served from cache when present, and
+// populate cache for ttl on a miss. Chain methods (Where, OrderBy,
+// Limit, ...) return a WithCache-wrapped chain of their own, so
+// caching survives the whole builder chain rather than only the call
+// site WithCache was applied to.
+func WithCache[T any](inner Interface[T], cache Cache, ttl time.Duration) Interface[T] {
+	return &cached[T]{Interface: inner, cache: cache, ttl: ttl}
+}
+
+func (c *cached[T]) rewrap(next Interface[T]) Interface[T] {
+	return &cached[T]{Interface: next, cache: c.cache, ttl: c.ttl}
+}
+
+func (c *cached[T]) Where(conds ...field.Expr) Interface[T] {
+	return c.rewrap(c.Interface.Where(conds...))
+}
+
+func (c *cached[T]) Order(values ...interface{}) Interface[T] {
+	return c.rewrap(c.Interface.Order(values...))
+}
+
+func (c *cached[T]) OrderBy(columns ...field.OrderableInterface) Interface[T] {
+	return c.rewrap(c.Interface.OrderBy(columns...))
+}
+
+func (c *cached[T]) GroupBy(columns ...field.Columner) Interface[T] {
+	return c.rewrap(c.Interface.GroupBy(columns...))
+}
+
+func (c *cached[T]) Preload(association string, args ...interface{}) Interface[T] {
+	return c.rewrap(c.Interface.Preload(association, args...))
+}
+
+func (c *cached[T]) Returning(columns ...field.Columner) Interface[T] {
+	return c.rewrap(c.Interface.Returning(columns...))
+}
+
+func (c *cached[T]) SelectExcept(columns ...field.Columner) Interface[T] {
+	return c.rewrap(c.Interface.SelectExcept(columns...))
+}
+
+func (c *cached[T]) Limit(limit int) Interface[T] {
+	return c.rewrap(c.Interface.Limit(limit))
+}
+
+func (c *cached[T]) Offset(offset int) Interface[T] {
+	return c.rewrap(c.Interface.Offset(offset))
+}
+
+func (c *cached[T]) Set(assignments ...field.Assignment) Interface[T] {
+	return c.rewrap(c.Interface.Set(assignments...))
+}
+
+func (c *cached[T]) ForUpdate() Interface[T] {
+	return c.rewrap(c.Interface.ForUpdate())
+}
+
+func (c *cached[T]) ForShare() Interface[T] {
+	return c.rewrap(c.Interface.ForShare())
+}
+
+func (c *cached[T]) SkipLocked() Interface[T] {
+	return c.rewrap(c.Interface.SkipLocked())
+}
+
+func (c *cached[T]) NoWait() Interface[T] {
+	return c.rewrap(c.Interface.NoWait())
+}
+
+func (c *cached[T]) Hints(hints ...clause.Expression) Interface[T] {
+	return c.rewrap(c.Interface.Hints(hints...))
+}
+
+func (c *cached[T]) Scopes(funcs ...func(*gorm.DB) *gorm.DB) Interface[T] {
+	return c.rewrap(c.Interface.Scopes(funcs...))
+}
+
+func (c *cached[T]) Unscoped() Interface[T] {
+	return c.rewrap(c.Interface.Unscoped())
+}
+
+func (c *cached[T]) UseReplica() Interface[T] {
+	return c.rewrap(c.Interface.UseReplica())
+}
+
+func (c *cached[T]) UsePrimary() Interface[T] {
+	return c.rewrap(c.Interface.UsePrimary())
+}
+
+func (c *cached[T]) WithContext(ctx context.Context) Interface[T] {
+	return c.rewrap(c.Interface.WithContext(ctx))
+}
+
+func (c *cached[T]) Debug() Interface[T] {
+	return c.rewrap(c.Interface.Debug())
+}
+
+// cacheKey builds the cache key for method, the chain's SQL text as
+// returned by ToSQL so that two chains with different predicates,
+// ordering, or limits never collide.
+func (c *cached[T]) cacheKey(ctx context.Context, method string) string {
+	return method + ":" + c.Interface.ToSQL(ctx)
+}
+
+func (c *cached[T]) Find() ([]*T, error) {
+	ctx := context.Background()
+	key := c.cacheKey(ctx, "Find")
+	if v, ok := c.cache.Get(ctx, key); ok {
+		if results, ok := v.([]*T); ok {
+			return results, nil
+		}
+	}
+	results, err := c.Interface.Find()
+	if err == nil {
+		c.cache.Set(ctx, key, results, c.ttl)
+	}
+	return results, err
+}
+
+func (c *cached[T]) First() (*T, error) {
+	ctx := context.Background()
+	key := c.cacheKey(ctx, "First")
+	if v, ok := c.cache.Get(ctx, key); ok {
+		if result, ok := v.(*T); ok {
+			return result, nil
+		}
+	}
+	result, err := c.Interface.First()
+	if err == nil {
+		c.cache.Set(ctx, key, result, c.ttl)
+	}
+	return result, err
+}
+
+func (c *cached[T]) Take() (*T, error) {
+	ctx := context.Background()
+	key := c.cacheKey(ctx, "Take")
+	if v, ok := c.cache.Get(ctx, key); ok {
+		if result, ok := v.(*T); ok {
+			return result, nil
+		}
+	}
+	result, err := c.Interface.Take()
+	if err == nil {
+		c.cache.Set(ctx, key, result, c.ttl)
+	}
+	return result, err
+}
+
+func (c *cached[T]) Count() (int64, error) {
+	ctx := context.Background()
+	key := c.cacheKey(ctx, "Count")
+	if v, ok := c.cache.Get(ctx, key); ok {
+		if count, ok := v.(int64); ok {
+			return count, nil
+		}
+	}
+	count, err := c.Interface.Count()
+	if err == nil {
+		c.cache.Set(ctx, key, count, c.ttl)
+	}
+	return count, err
+}
+
+func (c *cached[T]) CountOf(ctx context.Context, col field.Columner) (int64, error) {
+	key := c.cacheKey(ctx, "CountOf:"+col.Column().Name)
+	if v, ok := c.cache.Get(ctx, key); ok {
+		if count, ok := v.(int64); ok {
+			return count, nil
+		}
+	}
+	count, err := c.Interface.CountOf(ctx, col)
+	if err == nil {
+		c.cache.Set(ctx, key, count, c.ttl)
+	}
+	return count, err
+}
+
+func (c *cached[T]) CountDistinctOf(ctx context.Context, col field.Columner) (int64, error) {
+	key := c.cacheKey(ctx, "CountDistinctOf:"+col.Column().Name)
+	if v, ok := c.cache.Get(ctx, key); ok {
+		if count, ok := v.(int64); ok {
+			return count, nil
+		}
+	}
+	count, err := c.Interface.CountDistinctOf(ctx, col)
+	if err == nil {
+		c.cache.Set(ctx, key, count, c.ttl)
+	}
+	return count, err
+}
+
+func (c *cached[T]) Exists(ctx context.Context) (bool, error) {
+	key := c.cacheKey(ctx, "Exists")
+	if v, ok := c.cache.Get(ctx, key); ok {
+		if exists, ok := v.(bool); ok {
+			return exists, nil
+		}
+	}
+	exists, err := c.Interface.Exists(ctx)
+	if err == nil {
+		c.cache.Set(ctx, key, exists, c.ttl)
+	}
+	return exists, err
+}
@@ -0,0 +1,36 @@
+package gen
+
+import (
+	"gorm.io/gorm/clause"
+
+	"github.com/go-gorm/cli/field"
+)
+
+// ConflictBuilder configures an upsert for the next Create call, built
+// via Interface[T].OnConflict.
+type ConflictBuilder[T any] struct {
+	q       *g[T]
+	columns []clause.Column
+}
+
+// DoUpdate finishes the upsert: when a row conflicts on the configured
+// columns, apply assignments to it instead of erroring.
+func (b ConflictBuilder[T]) DoUpdate(assignments ...field.Assignment) Interface[T] {
+	set := make(clause.Set, len(assignments))
+	for i, a := range assignments {
+		set[i] = clause.Assignment{Column: clause.Column{Name: a.Column.Name}, Value: a.Value}
+	}
+	return &g[T]{db: b.q.db.Clauses(clause.OnConflict{
+		Columns:   b.columns,
+		DoUpdates: set,
+	})}
+}
+
+// DoNothing finishes the upsert: when a row conflicts on the
+// configured columns, leave it untouched instead of erroring.
+func (b ConflictBuilder[T]) DoNothing() Interface[T] {
+	return &g[T]{db: b.q.db.Clauses(clause.OnConflict{
+		Columns:   b.columns,
+		DoNothing: true,
+	})}
+}
@@ -0,0 +1,54 @@
+package gen
+
+import (
+	"testing"
+
+	"github.com/go-gorm/cli/field"
+)
+
+func TestOnConflictDoUpdate(t *testing.T) {
+	db := newTestDB(t)
+	id := field.NewField("test_models", "id")
+
+	q := Use[testModel](db)
+	if err := q.Create(&testModel{ID: 1, Name: "bob"}); err != nil {
+		t.Fatal(err)
+	}
+
+	updated := field.NewField("test_models", "name").SetExpr(field.ExprOf[string]("?", "bobby"))
+	err := Use[testModel](db).OnConflict(id).DoUpdate(updated).Create(&testModel{ID: 1, Name: "bobby"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := Use[testModel](db).Where(nameEq("bobby")).First()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.ID != 1 {
+		t.Errorf("got %+v", got)
+	}
+}
+
+func TestOnConflictDoNothing(t *testing.T) {
+	db := newTestDB(t)
+	id := field.NewField("test_models", "id")
+
+	q := Use[testModel](db)
+	if err := q.Create(&testModel{ID: 1, Name: "bob"}); err != nil {
+		t.Fatal(err)
+	}
+
+	err := Use[testModel](db).OnConflict(id).DoNothing().Create(&testModel{ID: 1, Name: "bobby"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := Use[testModel](db).Where(nameEq("bob")).Count()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != 1 {
+		t.Errorf("count = %d, want 1", got)
+	}
+}
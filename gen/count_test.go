@@ -0,0 +1,62 @@
+package gen
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-gorm/cli/field"
+)
+
+func TestCountOf(t *testing.T) {
+	db := newTestDB(t)
+	q := Use[testModel](db)
+	for _, name := range []string{"bob", "alice", "bob"} {
+		if err := q.Create(&testModel{Name: name}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	count, err := q.CountOf(context.Background(), field.NewString("test_models", "name"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 3 {
+		t.Errorf("count = %d, want 3", count)
+	}
+}
+
+func TestCountDistinctOf(t *testing.T) {
+	db := newTestDB(t)
+	q := Use[testModel](db)
+	for _, name := range []string{"bob", "alice", "bob"} {
+		if err := q.Create(&testModel{Name: name}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	count, err := q.CountDistinctOf(context.Background(), field.NewString("test_models", "name"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Errorf("count = %d, want 2 distinct names", count)
+	}
+}
+
+func TestCountOfScopedByWhere(t *testing.T) {
+	db := newTestDB(t)
+	q := Use[testModel](db)
+	for _, name := range []string{"bob", "alice", "bob"} {
+		if err := q.Create(&testModel{Name: name}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	count, err := q.Where(nameEq("bob")).CountOf(context.Background(), field.NewString("test_models", "name"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Errorf("count = %d, want 2", count)
+	}
+}
@@ -0,0 +1,66 @@
+package gen
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"gorm.io/gorm/schema"
+
+	"github.com/go-gorm/cli/field"
+)
+
+// RegisterEncryptedSerializer registers name as a gorm serializer backed
+// by enc, so a model field tagged `gorm:"serializer:name"` is
+// transparently decrypted when scanned and encrypted when saved. This
+// is the model-struct half of field-level encryption; field.
+// EncryptedField covers the predicate/assignment half generated field
+// helpers use.
+func RegisterEncryptedSerializer(name string, enc field.Encryptor) {
+	schema.RegisterSerializer(name, encryptedSerializer{enc: enc})
+}
+
+// encryptedSerializer adapts a field.Encryptor to gorm's
+// schema.SerializerInterface.
+type encryptedSerializer struct {
+	enc field.Encryptor
+}
+
+// Scan implements schema.SerializerInterface, decrypting dbValue onto
+// dst. dbValue arrives as []byte from drivers that return text/blob
+// columns as raw bytes (mysql, and commonly postgres/sqlite depending
+// on column affinity) - fmt.Sprint on a []byte renders its decimal byte
+// dump instead of the stored ciphertext, so both cases are handled
+// explicitly rather than relying on fmt.Sprint's default formatting.
+func (s encryptedSerializer) Scan(ctx context.Context, f *schema.Field, dst reflect.Value, dbValue interface{}) error {
+	if dbValue == nil {
+		return nil
+	}
+
+	plaintext, err := s.enc.Decrypt(ciphertextString(dbValue))
+	if err != nil {
+		return err
+	}
+	return f.Set(ctx, dst, plaintext)
+}
+
+// ciphertextString normalizes dbValue to the string a driver's []byte
+// or string representation of a text/blob column encodes, so Scan
+// decrypts the actual stored ciphertext rather than fmt.Sprint's
+// decimal byte dump of a []byte.
+func ciphertextString(dbValue interface{}) string {
+	switch v := dbValue.(type) {
+	case []byte:
+		return string(v)
+	case string:
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// Value implements schema.SerializerInterface, encrypting fieldValue
+// for storage.
+func (s encryptedSerializer) Value(ctx context.Context, f *schema.Field, dst reflect.Value, fieldValue interface{}) (interface{}, error) {
+	return s.enc.Encrypt(fmt.Sprint(fieldValue))
+}
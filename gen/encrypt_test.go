@@ -0,0 +1,62 @@
+package gen
+
+import "testing"
+
+type reversingEncryptor struct{}
+
+func (reversingEncryptor) Encrypt(plaintext string) (string, error) {
+	runes := []rune(plaintext)
+	for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+		runes[i], runes[j] = runes[j], runes[i]
+	}
+	return string(runes), nil
+}
+
+func (reversingEncryptor) Decrypt(ciphertext string) (string, error) {
+	return reversingEncryptor{}.Encrypt(ciphertext)
+}
+
+type secretModel struct {
+	ID  uint
+	SSN string `gorm:"serializer:gen_test_reverse"`
+}
+
+func TestRegisterEncryptedSerializerRoundTrips(t *testing.T) {
+	RegisterEncryptedSerializer("gen_test_reverse", reversingEncryptor{})
+
+	db := newTestDB(t)
+	if err := db.AutoMigrate(&secretModel{}); err != nil {
+		t.Fatal(err)
+	}
+
+	q := Use[secretModel](db)
+	m := &secretModel{SSN: "123-45-6789"}
+	if err := q.Create(m); err != nil {
+		t.Fatal(err)
+	}
+
+	var raw string
+	if err := db.Raw("SELECT ssn FROM secret_models WHERE id = ?", m.ID).Scan(&raw).Error; err != nil {
+		t.Fatal(err)
+	}
+	if raw != "9876-54-321" {
+		t.Errorf("stored ssn = %q, want the encrypted form", raw)
+	}
+
+	result, err := q.First()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.SSN != "123-45-6789" {
+		t.Errorf("scanned ssn = %q, want the decrypted form", result.SSN)
+	}
+}
+
+func TestCiphertextStringHandlesByteSliceDriverValue(t *testing.T) {
+	if got := ciphertextString([]byte("9876-54-321")); got != "9876-54-321" {
+		t.Errorf("ciphertextString([]byte) = %q, want the raw string, not a byte dump", got)
+	}
+	if got := ciphertextString("9876-54-321"); got != "9876-54-321" {
+		t.Errorf("ciphertextString(string) = %q, want it unchanged", got)
+	}
+}
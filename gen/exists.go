@@ -0,0 +1,20 @@
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm/clause"
+)
+
+func (q *g[T]) Exists(ctx context.Context) (bool, error) {
+	var model T
+	var exists bool
+	err := q.db.WithContext(ctx).Model(&model).Select("count(*) > 0").Find(&exists).Error
+	return exists, err
+}
+
+// Exists builds a `WHERE EXISTS (subquery)` expression from another
+// typed query, for presence checks without a Count > 0 round trip.
+func Exists[T any](sub Interface[T]) clause.Expression {
+	return clause.Expr{SQL: "EXISTS (?)", Vars: []interface{}{sub.UnderlyingDB()}}
+}
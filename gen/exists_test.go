@@ -0,0 +1,47 @@
+package gen
+
+import (
+	"context"
+	"testing"
+)
+
+func TestExists(t *testing.T) {
+	db := newTestDB(t)
+	q := Use[testModel](db)
+	if err := q.Create(&testModel{Name: "bob"}); err != nil {
+		t.Fatal(err)
+	}
+
+	exists, err := Use[testModel](db).Where(nameEq("bob")).Exists(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !exists {
+		t.Error("exists = false, want true")
+	}
+
+	exists, err = Use[testModel](db).Where(nameEq("nobody")).Exists(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exists {
+		t.Error("exists = true, want false")
+	}
+}
+
+func TestExistsSubquery(t *testing.T) {
+	db := newTestDB(t)
+	q := Use[testModel](db)
+	if err := q.Create(&testModel{Name: "bob"}); err != nil {
+		t.Fatal(err)
+	}
+
+	sub := Use[testModel](db).Where(nameEq("bob")).UnderlyingDB().Model(&testModel{})
+	found, err := Use[testModel](db).Where(Exists(&g[testModel]{db: sub})).Find()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(found) != 1 {
+		t.Errorf("len(found) = %d, want 1", len(found))
+	}
+}
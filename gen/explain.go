@@ -0,0 +1,55 @@
+package gen
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// ToSQL returns the interpolated SQL the current chain's Find query
+// would run, without executing it, for debugging and logging typed
+// queries.
+func (q *g[T]) ToSQL(ctx context.Context) string {
+	var results []*T
+	return q.db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		return tx.WithContext(ctx).Find(&results)
+	})
+}
+
+// Explain returns the database's query plan for the current chain's
+// Find query, one plan row per string, for debugging slow queries.
+func (q *g[T]) Explain(ctx context.Context) ([]string, error) {
+	sql := q.ToSQL(ctx)
+
+	rows, err := q.db.WithContext(ctx).Raw("EXPLAIN " + sql).Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	vals := make([]interface{}, len(cols))
+	ptrs := make([]interface{}, len(cols))
+	for i := range vals {
+		ptrs[i] = &vals[i]
+	}
+
+	var plan []string
+	for rows.Next() {
+		if err := rows.Scan(ptrs...); err != nil {
+			return nil, err
+		}
+		parts := make([]string, len(cols))
+		for i, v := range vals {
+			parts[i] = fmt.Sprint(v)
+		}
+		plan = append(plan, strings.Join(parts, " "))
+	}
+	return plan, rows.Err()
+}
@@ -0,0 +1,33 @@
+package gen
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestToSQL(t *testing.T) {
+	db := newTestDB(t)
+	q := Use[testModel](db)
+
+	sql := q.Where(nameEq("bob")).ToSQL(context.Background())
+	if !strings.Contains(sql, "SELECT") || !strings.Contains(sql, `"bob"`) {
+		t.Errorf("ToSQL = %q, want interpolated SELECT with 'bob'", sql)
+	}
+}
+
+func TestExplain(t *testing.T) {
+	db := newTestDB(t)
+	q := Use[testModel](db)
+	if err := q.Create(&testModel{Name: "bob"}); err != nil {
+		t.Fatal(err)
+	}
+
+	plan, err := Use[testModel](db).Where(nameEq("bob")).Explain(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan) == 0 {
+		t.Error("plan is empty, want at least one row")
+	}
+}
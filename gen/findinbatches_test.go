@@ -0,0 +1,32 @@
+package gen
+
+import (
+	"context"
+	"testing"
+)
+
+func TestFindInBatches(t *testing.T) {
+	db := newTestDB(t)
+	q := Use[testModel](db)
+	for i := 0; i < 5; i++ {
+		if err := q.Create(&testModel{Name: "n"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var total, batches int
+	err := q.FindInBatches(context.Background(), 2, func(batch []*testModel, tx Interface[testModel]) error {
+		batches++
+		total += len(batch)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 5 {
+		t.Errorf("total = %d, want 5", total)
+	}
+	if batches != 3 {
+		t.Errorf("batches = %d, want 3", batches)
+	}
+}
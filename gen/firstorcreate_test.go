@@ -0,0 +1,48 @@
+package gen
+
+import (
+	"context"
+	"testing"
+)
+
+func TestFirstOrCreate(t *testing.T) {
+	db := newTestDB(t)
+	q := Use[testModel](db)
+
+	got, err := q.Where(nameEq("bob")).FirstOrCreate(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Name != "bob" || got.ID == 0 {
+		t.Errorf("got %+v", got)
+	}
+
+	count, err := Use[testModel](db).Where(nameEq("bob")).Count()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Errorf("count = %d, want 1", count)
+	}
+}
+
+func TestFirstOrInitDoesNotPersist(t *testing.T) {
+	db := newTestDB(t)
+	q := Use[testModel](db)
+
+	got, err := q.Where(nameEq("alice")).FirstOrInit(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Name != "alice" || got.ID != 0 {
+		t.Errorf("got %+v", got)
+	}
+
+	count, err := Use[testModel](db).Where(nameEq("alice")).Count()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 0 {
+		t.Errorf("count = %d, want 0", count)
+	}
+}
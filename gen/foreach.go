@@ -0,0 +1,26 @@
+package gen
+
+import "context"
+
+// ForEach streams rows matching the current chain to fn one at a time,
+// without materializing the full result set, for processing large tables
+// that would otherwise not fit in memory. It stops and returns fn's
+// error as soon as fn returns a non-nil error.
+func (q *g[T]) ForEach(ctx context.Context, fn func(row *T) error) error {
+	rows, err := q.db.WithContext(ctx).Model(new(T)).Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row T
+		if err := q.db.ScanRows(rows, &row); err != nil {
+			return err
+		}
+		if err := fn(&row); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
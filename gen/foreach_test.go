@@ -0,0 +1,55 @@
+package gen
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestForEach(t *testing.T) {
+	db := newTestDB(t)
+	q := Use[testModel](db)
+	for i := 0; i < 5; i++ {
+		if err := q.Create(&testModel{Name: "n"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var total int
+	err := q.ForEach(context.Background(), func(row *testModel) error {
+		total++
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 5 {
+		t.Errorf("total = %d, want 5", total)
+	}
+}
+
+func TestForEachStopsOnError(t *testing.T) {
+	db := newTestDB(t)
+	q := Use[testModel](db)
+	for i := 0; i < 5; i++ {
+		if err := q.Create(&testModel{Name: "n"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	wantErr := errors.New("stop")
+	var seen int
+	err := q.ForEach(context.Background(), func(row *testModel) error {
+		seen++
+		if seen == 2 {
+			return wantErr
+		}
+		return nil
+	})
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("err = %v, want %v", err, wantErr)
+	}
+	if seen != 2 {
+		t.Errorf("seen = %d, want 2", seen)
+	}
+}
@@ -0,0 +1,209 @@
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/go-gorm/cli/field"
+)
+
+// g is the default Interface[T] implementation, backed by *gorm.DB.
+type g[T any] struct {
+	db *gorm.DB
+}
+
+// Use builds an Interface[T] backed by db. Generated model packages
+// call this once per model to construct their exported query value.
+func Use[T any](db *gorm.DB) Interface[T] {
+	return &g[T]{db: db}
+}
+
+func (q *g[T]) Where(conds ...field.Expr) Interface[T] {
+	db := q.db
+	for _, c := range conds {
+		db = db.Where(c)
+	}
+	return &g[T]{db: db}
+}
+
+func (q *g[T]) Order(values ...interface{}) Interface[T] {
+	db := q.db
+	for _, v := range values {
+		db = db.Order(v)
+	}
+	return &g[T]{db: db}
+}
+
+func (q *g[T]) OrderBy(columns ...field.OrderableInterface) Interface[T] {
+	terms := append(currentOrderTerms(q.db.Statement.Clauses), columns...)
+	return &g[T]{db: q.db.Clauses(clause.OrderBy{Expression: terms})}
+}
+
+func (q *g[T]) Limit(limit int) Interface[T] {
+	return &g[T]{db: q.db.Limit(limit)}
+}
+
+func (q *g[T]) Offset(offset int) Interface[T] {
+	return &g[T]{db: q.db.Offset(offset)}
+}
+
+func (q *g[T]) Set(assignments ...field.Assignment) Interface[T] {
+	return &g[T]{db: q.db.Clauses(clause.Set(assignments))}
+}
+
+func (q *g[T]) OnConflict(columns ...field.Columner) ConflictBuilder[T] {
+	cols := make([]clause.Column, len(columns))
+	for i, c := range columns {
+		cols[i] = clause.Column{Name: c.Column().Name}
+	}
+	return ConflictBuilder[T]{q: q, columns: cols}
+}
+
+func (q *g[T]) GroupBy(columns ...field.Columner) Interface[T] {
+	cols := make([]clause.Column, len(columns))
+	for i, c := range columns {
+		cols[i] = c.Column()
+	}
+	return &g[T]{db: q.db.Clauses(clause.GroupBy{Columns: cols})}
+}
+
+func (q *g[T]) Returning(columns ...field.Columner) Interface[T] {
+	cols := make([]clause.Column, len(columns))
+	for i, c := range columns {
+		cols[i] = c.Column()
+	}
+	return &g[T]{db: q.db.Clauses(clause.Returning{Columns: cols})}
+}
+
+func (q *g[T]) SelectExcept(columns ...field.Columner) Interface[T] {
+	excluded := make(map[string]bool, len(columns))
+	for _, c := range columns {
+		excluded[c.Column().Name] = true
+	}
+
+	var model T
+	stmt := &gorm.Statement{DB: q.db}
+	if err := stmt.Parse(&model); err != nil {
+		db := q.db
+		db.AddError(err)
+		return &g[T]{db: db}
+	}
+
+	keep := make([]string, 0, len(stmt.Schema.DBNames))
+	for _, name := range stmt.Schema.DBNames {
+		if !excluded[name] {
+			keep = append(keep, name)
+		}
+	}
+	return &g[T]{db: q.db.Select(keep)}
+}
+
+func (q *g[T]) Find() ([]*T, error) {
+	var results []*T
+	err := q.db.Find(&results).Error
+	return results, err
+}
+
+func (q *g[T]) FindInBatches(ctx context.Context, batchSize int, fc func(batch []*T, tx Interface[T]) error) error {
+	var results []*T
+	return q.db.WithContext(ctx).FindInBatches(&results, batchSize, func(txDB *gorm.DB, batch int) error {
+		return fc(results, &g[T]{db: txDB})
+	}).Error
+}
+
+func (q *g[T]) First() (*T, error) {
+	var result T
+	if err := q.db.First(&result).Error; err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (q *g[T]) Take() (*T, error) {
+	var result T
+	if err := q.db.Take(&result).Error; err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (q *g[T]) FirstOrCreate(ctx context.Context) (*T, error) {
+	var result T
+	if err := q.db.WithContext(ctx).FirstOrCreate(&result).Error; err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (q *g[T]) FirstOrInit(ctx context.Context) (*T, error) {
+	var result T
+	if err := q.db.WithContext(ctx).FirstOrInit(&result).Error; err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (q *g[T]) Count() (int64, error) {
+	var count int64
+	var model T
+	err := q.db.Model(&model).Count(&count).Error
+	return count, err
+}
+
+func (q *g[T]) CountOf(ctx context.Context, col field.Columner) (int64, error) {
+	var count int64
+	var model T
+	err := q.db.WithContext(ctx).Model(&model).Select(col.Column().Name).Count(&count).Error
+	return count, err
+}
+
+func (q *g[T]) CountDistinctOf(ctx context.Context, col field.Columner) (int64, error) {
+	var count int64
+	var model T
+	err := q.db.WithContext(ctx).Model(&model).Distinct().Select(col.Column().Name).Count(&count).Error
+	return count, err
+}
+
+func (q *g[T]) Create(value *T) error {
+	if err := validate(value); err != nil {
+		return err
+	}
+	return q.db.Create(value).Error
+}
+
+func (q *g[T]) CreateInBatches(ctx context.Context, records []*T, batchSize int) error {
+	return q.db.WithContext(ctx).CreateInBatches(records, batchSize).Error
+}
+
+func (q *g[T]) Save(value *T) error {
+	if err := validate(value); err != nil {
+		return err
+	}
+	return q.db.Save(value).Error
+}
+
+func (q *g[T]) Updates() (int64, error) {
+	var model T
+	tx := q.db.Model(&model).Updates(map[string]interface{}{})
+	return tx.RowsAffected, tx.Error
+}
+
+func (q *g[T]) Delete() (int64, error) {
+	var model T
+	tx := q.db.Delete(&model)
+	return tx.RowsAffected, tx.Error
+}
+
+func (q *g[T]) WithContext(ctx context.Context) Interface[T] {
+	return &g[T]{db: q.db.WithContext(ctx)}
+}
+
+func (q *g[T]) Debug() Interface[T] {
+	return &g[T]{db: q.db.Debug()}
+}
+
+func (q *g[T]) UnderlyingDB() *gorm.DB {
+	return q.db
+}
@@ -0,0 +1,32 @@
+package gen
+
+import (
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/go-gorm/cli/field"
+)
+
+type testModel struct {
+	ID     uint
+	Name   string
+	Active bool
+}
+
+func newTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	if err := db.AutoMigrate(&testModel{}); err != nil {
+		t.Fatalf("automigrate: %v", err)
+	}
+	return db
+}
+
+func nameEq(name string) field.Expr {
+	return field.NewString("test_models", "name").Eq(name)
+}
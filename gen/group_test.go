@@ -0,0 +1,46 @@
+package gen
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/go-gorm/cli/field"
+)
+
+func TestGroupByColumn(t *testing.T) {
+	db := newTestDB(t)
+	q := Use[testModel](db)
+	for _, name := range []string{"bob", "alice", "bob"} {
+		if err := q.Create(&testModel{Name: name}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	sql := q.GroupBy(field.NewString("test_models", "name")).ToSQL(context.Background())
+	if !strings.Contains(sql, "GROUP BY") || !strings.Contains(sql, "`name`") {
+		t.Errorf("ToSQL = %q, want a GROUP BY on name", sql)
+	}
+}
+
+func TestGroupByExpr(t *testing.T) {
+	db := newTestDB(t)
+	q := Use[testModel](db)
+
+	sql := q.GroupBy(field.GroupExpr("substr(name, 1, 1)")).ToSQL(context.Background())
+	if !strings.Contains(sql, "GROUP BY substr(name, 1, 1)") {
+		t.Errorf("ToSQL = %q, want the raw expression written verbatim", sql)
+	}
+}
+
+func TestGroupByAccumulates(t *testing.T) {
+	db := newTestDB(t)
+	q := Use[testModel](db)
+
+	sql := q.GroupBy(field.NewString("test_models", "name")).
+		GroupBy(field.NewField("test_models", "id")).
+		ToSQL(context.Background())
+	if !strings.Contains(sql, "`test_models`.`name`,`test_models`.`id`") {
+		t.Errorf("ToSQL = %q, want both GroupBy calls to accumulate", sql)
+	}
+}
@@ -0,0 +1,11 @@
+package gen
+
+import "gorm.io/gorm/clause"
+
+// Hints applies optimizer/index hints to the chain, such as those built
+// by gorm.io/hints (hints.UseIndex, hints.ForceIndex, hints.Comment,
+// ...), so USE INDEX/FORCE INDEX and similar dialect hints can be
+// expressed without escaping to a raw clause.
+func (q *g[T]) Hints(hints ...clause.Expression) Interface[T] {
+	return &g[T]{db: q.db.Clauses(hints...)}
+}
@@ -0,0 +1,19 @@
+package gen
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"gorm.io/hints"
+)
+
+func TestHintsIndex(t *testing.T) {
+	db := newTestDB(t)
+	q := Use[testModel](db)
+
+	sql := q.Hints(hints.UseIndex("idx_name")).Where(nameEq("bob")).ToSQL(context.Background())
+	if !strings.Contains(sql, "USE INDEX (`idx_name`)") {
+		t.Errorf("ToSQL = %q, want it to contain USE INDEX (idx_name)", sql)
+	}
+}
@@ -0,0 +1,129 @@
+package gen
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Span reports one completed database operation, with enough
+// information for a caller to emit an OpenTelemetry span or increment
+// a Prometheus counter without gen depending on either library
+// directly.
+type Span struct {
+	// Operation is the gorm operation that ran: "create", "query",
+	// "update", "delete", "row", or "raw".
+	Operation string
+	// Table is the table the operation targeted.
+	Table string
+	// RowsAffected is the number of rows the operation affected, as
+	// reported by gorm.
+	RowsAffected int64
+	// Duration is how long the operation took.
+	Duration time.Duration
+	// Err is the error the operation returned, if any.
+	Err error
+}
+
+// InstrumentFunc receives one Span per completed database operation.
+type InstrumentFunc func(ctx context.Context, span Span)
+
+// instrumentStartKey is the sync.Map key Instrument's before-callbacks
+// use to stash the operation's start time for the matching
+// after-callback to read back.
+const instrumentStartKey = "gen:instrument:start"
+
+// instrumentedOperations lists the gorm callback processors Instrument
+// hooks, paired with the callback names bracketing each operation, so
+// every Interface[T] method built on db - Create, Find, Updates,
+// Delete, and raw Scan calls alike - reports a Span without wrapping
+// each generated method by hand.
+var instrumentedOperations = []struct {
+	name   string
+	before string
+	after  string
+}{
+	{"create", "gorm:before_create", "gorm:after_create"},
+	{"query", "gorm:query", "gorm:after_query"},
+	{"update", "gorm:before_update", "gorm:after_update"},
+	{"delete", "gorm:before_delete", "gorm:after_delete"},
+	{"row", "gorm:row", "gorm:row"},
+	{"raw", "gorm:raw", "gorm:raw"},
+}
+
+// Instrument registers observe on db's callback chain so it fires once
+// per completed database operation. Call it once per *gorm.DB - a
+// Session derived from an already-instrumented db reuses its
+// callbacks, and calling Instrument again on the same db duplicates
+// the registration, reporting every Span twice.
+func Instrument(db *gorm.DB, observe InstrumentFunc) error {
+	for _, op := range instrumentedOperations {
+		if err := instrumentOperation(db, op.name, op.before, op.after, observe); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func instrumentOperation(db *gorm.DB, operation, before, after string, observe InstrumentFunc) error {
+	startFn := func(tx *gorm.DB) {
+		tx.Statement.Settings.Store(instrumentStartKey, time.Now())
+	}
+	observeFn := func(tx *gorm.DB) {
+		var duration time.Duration
+		if started, ok := tx.Statement.Settings.Load(instrumentStartKey); ok {
+			duration = time.Since(started.(time.Time))
+		}
+		observe(tx.Statement.Context, Span{
+			Operation:    operation,
+			Table:        tx.Statement.Table,
+			RowsAffected: tx.Statement.RowsAffected,
+			Duration:     duration,
+			Err:          tx.Error,
+		})
+	}
+
+	// db.Callback().<Op>() returns gorm's unexported processor type, so
+	// the Before/After/Register chain has to be called inline per
+	// operation rather than through a shared helper that would need to
+	// name that type.
+	switch operation {
+	case "create":
+		cb := db.Callback().Create()
+		if err := cb.Before(before).Register("gen:instrument:"+operation+":before", startFn); err != nil {
+			return err
+		}
+		return cb.After(after).Register("gen:instrument:"+operation+":after", observeFn)
+	case "query":
+		cb := db.Callback().Query()
+		if err := cb.Before(before).Register("gen:instrument:"+operation+":before", startFn); err != nil {
+			return err
+		}
+		return cb.After(after).Register("gen:instrument:"+operation+":after", observeFn)
+	case "update":
+		cb := db.Callback().Update()
+		if err := cb.Before(before).Register("gen:instrument:"+operation+":before", startFn); err != nil {
+			return err
+		}
+		return cb.After(after).Register("gen:instrument:"+operation+":after", observeFn)
+	case "delete":
+		cb := db.Callback().Delete()
+		if err := cb.Before(before).Register("gen:instrument:"+operation+":before", startFn); err != nil {
+			return err
+		}
+		return cb.After(after).Register("gen:instrument:"+operation+":after", observeFn)
+	case "row":
+		cb := db.Callback().Row()
+		if err := cb.Before(before).Register("gen:instrument:"+operation+":before", startFn); err != nil {
+			return err
+		}
+		return cb.After(after).Register("gen:instrument:"+operation+":after", observeFn)
+	default:
+		cb := db.Callback().Raw()
+		if err := cb.Before(before).Register("gen:instrument:"+operation+":before", startFn); err != nil {
+			return err
+		}
+		return cb.After(after).Register("gen:instrument:"+operation+":after", observeFn)
+	}
+}
@@ -0,0 +1,70 @@
+package gen
+
+import (
+	"context"
+	"testing"
+)
+
+func TestInstrumentReportsCreateAndQuery(t *testing.T) {
+	db := newTestDB(t)
+
+	var spans []Span
+	if err := Instrument(db, func(ctx context.Context, span Span) {
+		spans = append(spans, span)
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	q := Use[testModel](db)
+	if err := q.Create(&testModel{Name: "bob"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := q.Find(); err != nil {
+		t.Fatal(err)
+	}
+
+	var sawCreate, sawQuery bool
+	for _, span := range spans {
+		if span.Table != "test_models" {
+			t.Errorf("span.Table = %q, want test_models", span.Table)
+		}
+		switch span.Operation {
+		case "create":
+			sawCreate = true
+			if span.RowsAffected != 1 {
+				t.Errorf("create span.RowsAffected = %d, want 1", span.RowsAffected)
+			}
+		case "query":
+			sawQuery = true
+		}
+	}
+	if !sawCreate || !sawQuery {
+		t.Errorf("spans = %+v, want both a create and a query span", spans)
+	}
+}
+
+func TestInstrumentReportsError(t *testing.T) {
+	db := newTestDB(t)
+
+	var spans []Span
+	if err := Instrument(db, func(ctx context.Context, span Span) {
+		spans = append(spans, span)
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := Use[testModel](db).UnderlyingDB().Raw("select * from no_such_table").Rows()
+	if err == nil {
+		t.Fatal("want error querying a nonexistent table")
+	}
+
+	var sawErr bool
+	for _, span := range spans {
+		if span.Operation == "row" && span.Err != nil {
+			sawErr = true
+		}
+	}
+	if !sawErr {
+		t.Errorf("spans = %+v, want a row span carrying the error", spans)
+	}
+}
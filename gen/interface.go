@@ -0,0 +1,172 @@
+// Package gen provides the generic, typed query interface that
+// generated per-model code implements. It wraps *gorm.DB so callers
+// build predicates and assignments from the companion field package
+// instead of raw strings and interface{} maps.
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/go-gorm/cli/field"
+)
+
+// Interface is implemented by every generated per-model query type.
+type Interface[T any] interface {
+	// Where ANDs the given predicates onto the current chain.
+	Where(conds ...field.Expr) Interface[T]
+	// Order appends ORDER BY terms to the current chain, accepting
+	// anything gorm's own Order does (column names, clause.OrderByColumn,
+	// raw expressions). Prefer OrderBy for compile-time-checked terms;
+	// genconfig can forbid this variant per project. See OrderBy.
+	Order(values ...interface{}) Interface[T]
+	// OrderBy appends ORDER BY terms built from the field package, so
+	// every term is checked against the model at compile time.
+	OrderBy(columns ...field.OrderableInterface) Interface[T]
+	// Limit sets the LIMIT clause.
+	Limit(limit int) Interface[T]
+	// Offset sets the OFFSET clause.
+	Offset(offset int) Interface[T]
+	// Set stages assignments consumed by the next Updates call.
+	Set(assignments ...field.Assignment) Interface[T]
+	// OnConflict configures an upsert for the next Create call; chain
+	// DoUpdate or DoNothing to finish it.
+	OnConflict(columns ...field.Columner) ConflictBuilder[T]
+	// GroupBy appends GROUP BY terms to the current chain. Plain
+	// columns work directly; group by a date truncation, JSON
+	// extraction, CASE statement, or other expression via
+	// field.GroupExpr.
+	GroupBy(columns ...field.Columner) Interface[T]
+	// Returning restricts the columns DeleteReturning/UpdateReturning
+	// report back, instead of every column. It has no effect without a
+	// following DeleteReturning or UpdateReturning call.
+	Returning(columns ...field.Columner) Interface[T]
+	// SelectExcept selects every column of the model except columns,
+	// for wide tables where a Find/First/Take shouldn't pay to load a
+	// couple of huge blob/JSON columns.
+	SelectExcept(columns ...field.Columner) Interface[T]
+	// ForUpdate locks matching rows with SELECT ... FOR UPDATE.
+	ForUpdate() Interface[T]
+	// ForShare locks matching rows with SELECT ... FOR SHARE.
+	ForShare() Interface[T]
+	// SkipLocked skips rows already locked by another transaction
+	// instead of blocking on them.
+	SkipLocked() Interface[T]
+	// NoWait fails immediately instead of blocking when a matching row
+	// is already locked by another transaction.
+	NoWait() Interface[T]
+	// Hints applies optimizer/index hints to the chain, such as those
+	// built by gorm.io/hints (hints.UseIndex, hints.ForceIndex,
+	// hints.Comment, ...).
+	Hints(hints ...clause.Expression) Interface[T]
+	// Preload eager-loads association, gorm's own gorm.io/gorm.DB.Preload
+	// under the hood, so it also accepts a nested association path
+	// ("Author.Manager") and args scoping which rows of the association
+	// load (a *gorm.DB clause, or a func(*gorm.DB) *gorm.DB). For a
+	// self-referential association, pair it with a model's generated
+	// <Model>Fields value and gen.As to give the joined rows a distinct
+	// alias and avoid ambiguous column errors.
+	Preload(association string, args ...interface{}) Interface[T]
+	// Scopes applies raw gorm scopes (func(*gorm.DB) *gorm.DB) to the
+	// chain, so scopes shared with code that hasn't migrated to
+	// Interface[T] keep working unchanged. See also the package-level
+	// Scope helper for the reverse direction.
+	Scopes(funcs ...func(*gorm.DB) *gorm.DB) Interface[T]
+	// Unscoped ignores soft-delete scoping for the rest of the chain,
+	// including the next Delete call, so that call issues a hard delete
+	// instead of setting the model's soft-delete column.
+	Unscoped() Interface[T]
+	// UseReplica tags the chain with a read-preference hint
+	// (ReadPreferenceSetting) for a read/write-splitting plugin, such
+	// as gorm.io/plugin/dbresolver wired in via Scopes, to route to a
+	// replica.
+	UseReplica() Interface[T]
+	// UsePrimary tags the chain with a read-preference hint
+	// (ReadPreferenceSetting) for a read/write-splitting plugin to
+	// route to the primary, overriding UseReplica.
+	UsePrimary() Interface[T]
+
+	// Find returns every row matching the current chain.
+	Find() ([]*T, error)
+	// FindInBatches loads rows matching the current chain batchSize at a
+	// time, invoking fc with each batch and a chain scoped to that
+	// batch's transaction, for processing large tables without loading
+	// everything into memory at once.
+	FindInBatches(ctx context.Context, batchSize int, fc func(batch []*T, tx Interface[T]) error) error
+	// ForEach streams rows matching the current chain to fn one at a
+	// time, without materializing the full result set, for processing
+	// large tables that would otherwise not fit in memory.
+	ForEach(ctx context.Context, fn func(row *T) error) error
+	// First returns the first row matching the current chain, ordered by primary key.
+	First() (*T, error)
+	// FindPage returns the 1-based page of rows matching the current
+	// chain alongside the total row count, ignoring any ORDER
+	// BY/LIMIT/OFFSET already staged when computing that total.
+	FindPage(ctx context.Context, page, perPage int) ([]*T, int64, error)
+	// Take returns one row matching the current chain with no implicit ordering.
+	Take() (*T, error)
+	// FirstOrCreate returns the first row matching the current chain,
+	// or creates one seeded from the chain's Where equality conditions
+	// and any Set assignments if none matches.
+	FirstOrCreate(ctx context.Context) (*T, error)
+	// FirstOrInit is FirstOrCreate without persisting the seeded value
+	// when no row matches.
+	FirstOrInit(ctx context.Context) (*T, error)
+	// Count returns the number of rows matching the current chain.
+	Count() (int64, error)
+	// CountOf returns the number of rows matching the current chain
+	// whose col is non-NULL, checked against the model at compile time
+	// so a misspelled column can't silently count every row instead.
+	CountOf(ctx context.Context, col field.Columner) (int64, error)
+	// CountDistinctOf is CountOf counting each distinct non-NULL value
+	// of col once.
+	CountDistinctOf(ctx context.Context, col field.Columner) (int64, error)
+	// Exists reports whether any row matches the current chain.
+	Exists(ctx context.Context) (bool, error)
+
+	// Create inserts value.
+	Create(value *T) error
+	// CreateInBatches inserts records batchSize rows at a time,
+	// honoring any OnConflict configured on the chain.
+	CreateInBatches(ctx context.Context, records []*T, batchSize int) error
+	// Save upserts value based on its primary key.
+	Save(value *T) error
+	// Updates applies the assignments staged via Set to rows matching the current chain.
+	Updates() (int64, error)
+	// Delete removes rows matching the current chain.
+	Delete() (int64, error)
+	// DeleteReturning deletes rows matching the current chain and
+	// returns them, via RETURNING on dialects that support it.
+	DeleteReturning(ctx context.Context) ([]T, error)
+	// UpdateReturning applies assignments to rows matching the current
+	// chain and returns the updated rows, via RETURNING on dialects
+	// that support it.
+	UpdateReturning(ctx context.Context, assignments ...field.Assignment) ([]T, error)
+	// Restore clears the soft-delete column on rows matching the
+	// current chain, undoing a prior soft Delete. It has no effect on
+	// models that don't soft-delete.
+	Restore(ctx context.Context) (int64, error)
+
+	// WithContext scopes the chain to ctx.
+	WithContext(ctx context.Context) Interface[T]
+	// Transaction runs fn inside a database transaction, passing it a
+	// chain scoped to that transaction. It commits if fn returns nil and
+	// rolls back otherwise.
+	Transaction(ctx context.Context, fn func(tx Interface[T]) error) error
+	// Debug enables verbose logging for the chain.
+	Debug() Interface[T]
+	// ToSQL returns the interpolated SQL the current chain's Find query
+	// would run, without executing it, for debugging and logging typed
+	// queries.
+	ToSQL(ctx context.Context) string
+	// Explain returns the database's query plan for the current chain's
+	// Find query, one plan row per string, for debugging slow queries.
+	Explain(ctx context.Context) ([]string, error)
+	// UnderlyingDB exposes the wrapped *gorm.DB as an escape hatch.
+	UnderlyingDB() *gorm.DB
+	// AsSubquery captures the current chain, aliased, for reuse as a
+	// derived table (Table, Joins) or an IN-subquery (field.Field.In).
+	AsSubquery(alias string) Subquery
+}
@@ -0,0 +1,39 @@
+package gen
+
+import "gorm.io/gorm/clause"
+
+// currentLocking returns the clause.Locking already staged on db, if any,
+// so the chainable locking helpers below can layer onto it instead of
+// clobbering each other.
+func currentLocking(clauses map[string]clause.Clause) clause.Locking {
+	if c, ok := clauses["FOR"]; ok {
+		if locking, ok := c.Expression.(clause.Locking); ok {
+			return locking
+		}
+	}
+	return clause.Locking{}
+}
+
+func (q *g[T]) ForUpdate() Interface[T] {
+	locking := currentLocking(q.db.Statement.Clauses)
+	locking.Strength = clause.LockingStrengthUpdate
+	return &g[T]{db: q.db.Clauses(locking)}
+}
+
+func (q *g[T]) ForShare() Interface[T] {
+	locking := currentLocking(q.db.Statement.Clauses)
+	locking.Strength = clause.LockingStrengthShare
+	return &g[T]{db: q.db.Clauses(locking)}
+}
+
+func (q *g[T]) SkipLocked() Interface[T] {
+	locking := currentLocking(q.db.Statement.Clauses)
+	locking.Options = clause.LockingOptionsSkipLocked
+	return &g[T]{db: q.db.Clauses(locking)}
+}
+
+func (q *g[T]) NoWait() Interface[T] {
+	locking := currentLocking(q.db.Statement.Clauses)
+	locking.Options = clause.LockingOptionsNoWait
+	return &g[T]{db: q.db.Clauses(locking)}
+}
@@ -0,0 +1,37 @@
+package gen
+
+import (
+	"reflect"
+	"testing"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func TestLockingChains(t *testing.T) {
+	db := newTestDB(t).Session(&gorm.Session{DryRun: true, SkipDefaultTransaction: true})
+
+	q := Use[testModel](db).ForUpdate().SkipLocked()
+	var results []*testModel
+	res := q.UnderlyingDB().Find(&results)
+
+	got := res.Statement.Clauses["FOR"].Expression
+	want := clause.Locking{Strength: clause.LockingStrengthUpdate, Options: clause.LockingOptionsSkipLocked}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("locking clause = %#v, want %#v", got, want)
+	}
+}
+
+func TestLockingForShareNoWait(t *testing.T) {
+	db := newTestDB(t).Session(&gorm.Session{DryRun: true, SkipDefaultTransaction: true})
+
+	q := Use[testModel](db).ForShare().NoWait()
+	var results []*testModel
+	res := q.UnderlyingDB().Find(&results)
+
+	got := res.Statement.Clauses["FOR"].Expression
+	want := clause.Locking{Strength: clause.LockingStrengthShare, Options: clause.LockingOptionsNoWait}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("locking clause = %#v, want %#v", got, want)
+	}
+}
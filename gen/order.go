@@ -0,0 +1,35 @@
+package gen
+
+import (
+	"gorm.io/gorm/clause"
+
+	"github.com/go-gorm/cli/field"
+)
+
+// orderTerms composes multiple field.OrderableInterface terms into a
+// single clause.Expression, so repeated OrderBy calls accumulate onto
+// one ORDER BY clause instead of clobbering each other the way gorm's
+// own clause.OrderBy.MergeClause would once Expression is set.
+type orderTerms []field.OrderableInterface
+
+func (o orderTerms) Build(builder clause.Builder) {
+	for i, term := range o {
+		if i > 0 {
+			builder.WriteByte(',')
+		}
+		term.OrderExpr().Build(builder)
+	}
+}
+
+func currentOrderTerms(clauses map[string]clause.Clause) orderTerms {
+	if c, ok := clauses["ORDER BY"]; ok {
+		// AddClause stores the merged clause.OrderBy, so the terms a
+		// previous OrderBy call staged sit in its Expression field.
+		if orderBy, ok := c.Expression.(clause.OrderBy); ok {
+			if terms, ok := orderBy.Expression.(orderTerms); ok {
+				return append(orderTerms(nil), terms...)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package gen
+
+import (
+	"testing"
+
+	"github.com/go-gorm/cli/field"
+)
+
+func TestOrderByTyped(t *testing.T) {
+	db := newTestDB(t)
+	q := Use[testModel](db)
+	if err := q.Create(&testModel{Name: "b"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := q.Create(&testModel{Name: "a"}); err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := Use[testModel](db).OrderBy(field.NewString("test_models", "name").Asc()).Find()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 || results[0].Name != "a" {
+		t.Errorf("results = %+v, want a before b", results)
+	}
+}
+
+func TestOrderUntypedPassthrough(t *testing.T) {
+	db := newTestDB(t)
+	q := Use[testModel](db)
+	if err := q.Create(&testModel{Name: "b"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := q.Create(&testModel{Name: "a"}); err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := Use[testModel](db).Order("name ASC").Find()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 || results[0].Name != "a" {
+		t.Errorf("results = %+v, want a before b", results)
+	}
+}
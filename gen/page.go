@@ -0,0 +1,25 @@
+package gen
+
+import "context"
+
+// FindPage runs Find with offset pagination (page is 1-based) and
+// reports the total row count matching the current filters, ignoring
+// any ORDER BY/LIMIT/OFFSET already staged on the chain.
+func (q *g[T]) FindPage(ctx context.Context, page, perPage int) ([]*T, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+
+	countDB := q.db.WithContext(ctx)
+	delete(countDB.Statement.Clauses, "ORDER BY")
+
+	var model T
+	var total int64
+	if err := countDB.Model(&model).Limit(-1).Offset(-1).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var results []*T
+	err := q.db.WithContext(ctx).Offset((page - 1) * perPage).Limit(perPage).Find(&results).Error
+	return results, total, err
+}
@@ -0,0 +1,27 @@
+package gen
+
+import (
+	"context"
+	"testing"
+)
+
+func TestFindPage(t *testing.T) {
+	db := newTestDB(t)
+	q := Use[testModel](db)
+	for i := 0; i < 5; i++ {
+		if err := q.Create(&testModel{Name: "user"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	items, total, err := Use[testModel](db).FindPage(context.Background(), 2, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 5 {
+		t.Errorf("total = %d, want 5", total)
+	}
+	if len(items) != 2 {
+		t.Errorf("len(items) = %d, want 2", len(items))
+	}
+}
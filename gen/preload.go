@@ -0,0 +1,5 @@
+package gen
+
+func (q *g[T]) Preload(association string, args ...interface{}) Interface[T] {
+	return &g[T]{db: q.db.Preload(association, args...)}
+}
@@ -0,0 +1,75 @@
+package gen
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/go-gorm/cli/field"
+)
+
+// employeeModel is self-referential (ManagerID -> employee_models.id),
+// the shape association helpers for self-joins target.
+type employeeModel struct {
+	ID        uint
+	Name      string
+	ManagerID sql.NullInt64
+	Manager   *employeeModel
+}
+
+type employeeFields struct {
+	ID        field.Field
+	Name      field.String
+	ManagerID field.Null[int64]
+}
+
+func TestPreloadLoadsAssociation(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	if err := db.AutoMigrate(&employeeModel{}); err != nil {
+		t.Fatalf("automigrate: %v", err)
+	}
+
+	q := Use[employeeModel](db)
+	boss := &employeeModel{Name: "boss"}
+	if err := q.Create(boss); err != nil {
+		t.Fatal(err)
+	}
+	report := &employeeModel{Name: "report", ManagerID: sql.NullInt64{Int64: int64(boss.ID), Valid: true}}
+	if err := q.Create(report); err != nil {
+		t.Fatal(err)
+	}
+
+	found, err := q.Preload("Manager").Where(field.NewString("employee_models", "name").Eq("report")).First()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found.Manager == nil || found.Manager.Name != "boss" {
+		t.Errorf("found.Manager = %+v, want the preloaded boss", found.Manager)
+	}
+}
+
+func TestSelfJoinAliasAvoidsAmbiguousColumn(t *testing.T) {
+	employees := employeeFields{
+		ID:        field.NewField("employee_models", "id"),
+		Name:      field.NewString("employee_models", "name"),
+		ManagerID: field.NewNull[int64]("employee_models", "manager_id"),
+	}
+
+	manager := As(employees, "manager")
+	if got := manager.ID.TableName(); got != "manager" {
+		t.Errorf("manager.ID.TableName() = %q, want %q", got, "manager")
+	}
+	if got := employees.ID.TableName(); got != "employee_models" {
+		t.Errorf("As mutated the original employees value; TableName() = %q", got)
+	}
+
+	joinCond := employees.ManagerID.EqCol(manager.ID)
+	if joinCond == nil {
+		t.Fatal("EqCol returned nil")
+	}
+}
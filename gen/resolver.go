@@ -0,0 +1,35 @@
+package gen
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ReadPreferenceSetting is the *gorm.Statement.Settings key UseReplica
+// and UsePrimary store their preference under, as a bool (true means
+// route to a replica). A read/write-splitting plugin (such as
+// gorm.io/plugin/dbresolver, wired in via Scopes) reads it back to
+// pick a connection pool for the chain's eventual query.
+const ReadPreferenceSetting = "gen:read_preference"
+
+// readPreference is a gorm.StatementModifier, gorm's hook for clauses
+// that adjust the statement itself rather than contributing SQL.
+type readPreference struct {
+	replica bool
+}
+
+func (r readPreference) ModifyStatement(stmt *gorm.Statement) {
+	stmt.Settings.Store(ReadPreferenceSetting, r.replica)
+}
+
+// Build satisfies clause.Expression; readPreference contributes no SQL
+// of its own, only the Settings entry from ModifyStatement.
+func (r readPreference) Build(clause.Builder) {}
+
+func (q *g[T]) UseReplica() Interface[T] {
+	return &g[T]{db: q.db.Clauses(readPreference{replica: true})}
+}
+
+func (q *g[T]) UsePrimary() Interface[T] {
+	return &g[T]{db: q.db.Clauses(readPreference{replica: false})}
+}
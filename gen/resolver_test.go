@@ -0,0 +1,23 @@
+package gen
+
+import "testing"
+
+func TestUseReplicaSetsReadPreference(t *testing.T) {
+	db := newTestDB(t)
+	q := Use[testModel](db).UseReplica()
+
+	v, ok := q.UnderlyingDB().Statement.Settings.Load(ReadPreferenceSetting)
+	if !ok || v != true {
+		t.Errorf("Settings[%q] = %v, %v, want true, true", ReadPreferenceSetting, v, ok)
+	}
+}
+
+func TestUsePrimaryOverridesUseReplica(t *testing.T) {
+	db := newTestDB(t)
+	q := Use[testModel](db).UseReplica().UsePrimary()
+
+	v, ok := q.UnderlyingDB().Statement.Settings.Load(ReadPreferenceSetting)
+	if !ok || v != false {
+		t.Errorf("Settings[%q] = %v, %v, want false, true", ReadPreferenceSetting, v, ok)
+	}
+}
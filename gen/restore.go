@@ -0,0 +1,30 @@
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// Unscoped ignores soft-delete scoping for the rest of the chain,
+// including the next Delete call, so that call issues a hard delete
+// instead of setting the model's soft-delete column.
+func (q *g[T]) Unscoped() Interface[T] {
+	return &g[T]{db: q.db.Unscoped()}
+}
+
+// Restore clears the soft-delete column on rows matching the current
+// chain, undoing a prior soft Delete. It has no effect on models that
+// don't soft-delete.
+func (q *g[T]) Restore(ctx context.Context) (int64, error) {
+	var model T
+	stmt := &gorm.Statement{DB: q.db}
+	if err := stmt.Parse(&model); err != nil {
+		return 0, err
+	}
+	if stmt.Schema.LookUpField("DeletedAt") == nil {
+		return 0, nil
+	}
+	tx := q.db.WithContext(ctx).Unscoped().Model(&model).Update("DeletedAt", nil)
+	return tx.RowsAffected, tx.Error
+}
@@ -0,0 +1,75 @@
+package gen
+
+import (
+	"context"
+	"testing"
+
+	"gorm.io/gorm"
+
+	"github.com/go-gorm/cli/field"
+)
+
+type softDeleteModel struct {
+	ID        uint
+	Name      string
+	DeletedAt gorm.DeletedAt
+}
+
+func TestUnscopedDeleteIsHard(t *testing.T) {
+	db := newTestDB(t)
+	if err := db.AutoMigrate(&softDeleteModel{}); err != nil {
+		t.Fatalf("automigrate: %v", err)
+	}
+	q := Use[softDeleteModel](db)
+	if err := q.Create(&softDeleteModel{Name: "bob"}); err != nil {
+		t.Fatal(err)
+	}
+
+	nameEq := field.NewString("soft_delete_models", "name").Eq("bob")
+	if _, err := q.Unscoped().Where(nameEq).Delete(); err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := Use[softDeleteModel](db).Unscoped().Count()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 0 {
+		t.Errorf("count = %d, want 0 after hard delete", count)
+	}
+}
+
+func TestRestore(t *testing.T) {
+	db := newTestDB(t)
+	if err := db.AutoMigrate(&softDeleteModel{}); err != nil {
+		t.Fatalf("automigrate: %v", err)
+	}
+	q := Use[softDeleteModel](db)
+	if err := q.Create(&softDeleteModel{Name: "bob"}); err != nil {
+		t.Fatal(err)
+	}
+	nameEq := field.NewString("soft_delete_models", "name").Eq("bob")
+	if _, err := q.Where(nameEq).Delete(); err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := Use[softDeleteModel](db).Count()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 0 {
+		t.Fatalf("count = %d, want 0 after soft delete", count)
+	}
+
+	if _, err := Use[softDeleteModel](db).Unscoped().Where(nameEq).Restore(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = Use[softDeleteModel](db).Count()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Errorf("count = %d, want 1 after Restore", count)
+	}
+}
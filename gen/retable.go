@@ -0,0 +1,40 @@
+package gen
+
+import "reflect"
+
+// WithTable returns a copy of a generated model value (a struct whose
+// fields are field.Bool/field.String/... values) with every field
+// retargeted to table, by calling each field's own WithTable method.
+// It's the whole-model counterpart to the per-field WithTable methods,
+// for self-joins and aliased subqueries.
+func WithTable[M any](model M, table string) M {
+	out := model
+	v := reflect.ValueOf(&out).Elem()
+	retableFields(v, table)
+	return out
+}
+
+// As is WithTable under the name used for query aliases, e.g.
+// `u := gen.As(User, "u")`.
+func As[M any](model M, alias string) M {
+	return WithTable(model, alias)
+}
+
+func retableFields(v reflect.Value, table string) {
+	if v.Kind() != reflect.Struct {
+		return
+	}
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		fv := v.Field(i)
+		if !fv.CanSet() {
+			continue
+		}
+		method := fv.MethodByName("WithTable")
+		if !method.IsValid() || method.Type().NumIn() != 1 || method.Type().NumOut() != 1 {
+			continue
+		}
+		results := method.Call([]reflect.Value{reflect.ValueOf(table)})
+		fv.Set(results[0])
+	}
+}
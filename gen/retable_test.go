@@ -0,0 +1,44 @@
+package gen
+
+import (
+	"testing"
+
+	"github.com/go-gorm/cli/field"
+)
+
+type userModel struct {
+	ID     field.Bool
+	Name   field.String
+	Region field.Inet
+}
+
+func TestWithTableRetargetsEveryField(t *testing.T) {
+	user := userModel{
+		ID:     field.NewBool("users", "id"),
+		Name:   field.NewString("users", "name"),
+		Region: field.NewInet("users", "region"),
+	}
+
+	aliased := WithTable(user, "u")
+
+	if got := aliased.ID.TableName(); got != "u" {
+		t.Errorf("ID.TableName() = %q, want %q", got, "u")
+	}
+	if got := aliased.Name.TableName(); got != "u" {
+		t.Errorf("Name.TableName() = %q, want %q", got, "u")
+	}
+	if got := aliased.Region.TableName(); got != "u" {
+		t.Errorf("Region.TableName() = %q, want %q", got, "u")
+	}
+
+	if user.ID.TableName() != "users" {
+		t.Errorf("original model was mutated")
+	}
+}
+
+func TestAsIsWithTable(t *testing.T) {
+	user := userModel{ID: field.NewBool("users", "id")}
+	if got := As(user, "u").ID.TableName(); got != "u" {
+		t.Errorf("As(...).ID.TableName() = %q, want %q", got, "u")
+	}
+}
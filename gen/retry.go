@@ -0,0 +1,303 @@
+package gen
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/go-gorm/cli/field"
+)
+
+// Policy configures WithPolicy's retry and timeout behavior, the
+// runtime counterpart to a method's `// retry: N, backoff: D` or
+// `// timeout: D` annotation.
+type Policy struct {
+	// MaxAttempts is the total number of attempts, including the
+	// first. Values less than 1 are treated as 1 (no retry).
+	MaxAttempts int
+	// Backoff is the flat delay between attempts.
+	Backoff time.Duration
+	// Timeout, if nonzero, bounds each individual attempt with a
+	// context deadline; it does not bound the call as a whole across
+	// retries.
+	Timeout time.Duration
+}
+
+func (p Policy) attempts() int {
+	if p.MaxAttempts < 1 {
+		return 1
+	}
+	return p.MaxAttempts
+}
+
+// retryableErrorSubstrings are the lowercase substrings run looks for
+// in a failed attempt's error, covering the serialization-failure and
+// deadlock wording of postgres, mysql, and sqlite's drivers rather
+// than any one driver's specific error type.
+var retryableErrorSubstrings = []string{
+	"deadlock",
+	"serialization failure",
+	"database is locked",
+	"try restarting transaction",
+}
+
+// isRetryableError reports whether err looks like a transient
+// serialization failure or deadlock that another attempt could
+// reasonably recover from.
+func isRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+	for _, s := range retryableErrorSubstrings {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// run executes fn up to p's MaxAttempts times, sleeping Backoff
+// between attempts, and stops as soon as fn succeeds or fails with a
+// non-retryable error. Each attempt gets a fresh context derived from
+// parent and bounded by Timeout, if set.
+func (p Policy) run(parent context.Context, fn func(ctx context.Context) error) error {
+	var err error
+	for attempt := 0; attempt < p.attempts(); attempt++ {
+		if attempt > 0 && p.Backoff > 0 {
+			time.Sleep(p.Backoff)
+		}
+
+		ctx := parent
+		cancel := func() {}
+		if p.Timeout > 0 {
+			ctx, cancel = context.WithTimeout(parent, p.Timeout)
+		}
+		err = fn(ctx)
+		cancel()
+
+		if err == nil || !isRetryableError(err) {
+			return err
+		}
+	}
+	return err
+}
+
+// policied decorates an Interface[T], running Find, First, Take,
+// Count, CountOf, CountDistinctOf, Exists, Create, Save, Updates, and
+// Delete through Policy's retry/timeout loop. Every other method
+// delegates to the embedded Interface[T] unmodified.
+type policied[T any] struct {
+	Interface[T]
+	policy Policy
+	// ctx is the context the caller last set via WithContext, the
+	// parent run bounds each attempt's timeout from. It defaults to
+	// context.Background() so callers that never call WithContext keep
+	// working as before.
+	ctx context.Context
+}
+
+// WithPolicy decorates inner so its most commonly retried methods
+// (Find, First, Take, Count, CountOf, CountDistinctOf, Exists, Create,
+// Save, Updates, Delete) run under policy: a context deadline per
+// attempt and a retry loop on
+// serialization-failure/deadlock errors. Chain methods (Where,
+// OrderBy, Limit, ...) return a WithPolicy-wrapped chain of their own,
+// so the policy survives the whole builder chain rather than only the
+// call site WithPolicy was applied to.
+func WithPolicy[T any](inner Interface[T], policy Policy) Interface[T] {
+	return &policied[T]{Interface: inner, policy: policy, ctx: context.Background()}
+}
+
+func (p *policied[T]) rewrap(next Interface[T]) Interface[T] {
+	return &policied[T]{Interface: next, policy: p.policy, ctx: p.ctx}
+}
+
+func (p *policied[T]) Where(conds ...field.Expr) Interface[T] {
+	return p.rewrap(p.Interface.Where(conds...))
+}
+
+func (p *policied[T]) Order(values ...interface{}) Interface[T] {
+	return p.rewrap(p.Interface.Order(values...))
+}
+
+func (p *policied[T]) OrderBy(columns ...field.OrderableInterface) Interface[T] {
+	return p.rewrap(p.Interface.OrderBy(columns...))
+}
+
+func (p *policied[T]) GroupBy(columns ...field.Columner) Interface[T] {
+	return p.rewrap(p.Interface.GroupBy(columns...))
+}
+
+func (p *policied[T]) Preload(association string, args ...interface{}) Interface[T] {
+	return p.rewrap(p.Interface.Preload(association, args...))
+}
+
+func (p *policied[T]) Returning(columns ...field.Columner) Interface[T] {
+	return p.rewrap(p.Interface.Returning(columns...))
+}
+
+func (p *policied[T]) SelectExcept(columns ...field.Columner) Interface[T] {
+	return p.rewrap(p.Interface.SelectExcept(columns...))
+}
+
+func (p *policied[T]) Limit(limit int) Interface[T] {
+	return p.rewrap(p.Interface.Limit(limit))
+}
+
+func (p *policied[T]) Offset(offset int) Interface[T] {
+	return p.rewrap(p.Interface.Offset(offset))
+}
+
+func (p *policied[T]) Set(assignments ...field.Assignment) Interface[T] {
+	return p.rewrap(p.Interface.Set(assignments...))
+}
+
+func (p *policied[T]) ForUpdate() Interface[T] {
+	return p.rewrap(p.Interface.ForUpdate())
+}
+
+func (p *policied[T]) ForShare() Interface[T] {
+	return p.rewrap(p.Interface.ForShare())
+}
+
+func (p *policied[T]) SkipLocked() Interface[T] {
+	return p.rewrap(p.Interface.SkipLocked())
+}
+
+func (p *policied[T]) NoWait() Interface[T] {
+	return p.rewrap(p.Interface.NoWait())
+}
+
+func (p *policied[T]) Hints(hints ...clause.Expression) Interface[T] {
+	return p.rewrap(p.Interface.Hints(hints...))
+}
+
+func (p *policied[T]) Scopes(funcs ...func(*gorm.DB) *gorm.DB) Interface[T] {
+	return p.rewrap(p.Interface.Scopes(funcs...))
+}
+
+func (p *policied[T]) Unscoped() Interface[T] {
+	return p.rewrap(p.Interface.Unscoped())
+}
+
+func (p *policied[T]) UseReplica() Interface[T] {
+	return p.rewrap(p.Interface.UseReplica())
+}
+
+func (p *policied[T]) UsePrimary() Interface[T] {
+	return p.rewrap(p.Interface.UsePrimary())
+}
+
+func (p *policied[T]) WithContext(ctx context.Context) Interface[T] {
+	return &policied[T]{Interface: p.Interface.WithContext(ctx), policy: p.policy, ctx: ctx}
+}
+
+func (p *policied[T]) Debug() Interface[T] {
+	return p.rewrap(p.Interface.Debug())
+}
+
+func (p *policied[T]) Find() ([]*T, error) {
+	var results []*T
+	err := p.policy.run(p.ctx, func(ctx context.Context) error {
+		var err error
+		results, err = p.Interface.WithContext(ctx).Find()
+		return err
+	})
+	return results, err
+}
+
+func (p *policied[T]) First() (*T, error) {
+	var result *T
+	err := p.policy.run(p.ctx, func(ctx context.Context) error {
+		var err error
+		result, err = p.Interface.WithContext(ctx).First()
+		return err
+	})
+	return result, err
+}
+
+func (p *policied[T]) Take() (*T, error) {
+	var result *T
+	err := p.policy.run(p.ctx, func(ctx context.Context) error {
+		var err error
+		result, err = p.Interface.WithContext(ctx).Take()
+		return err
+	})
+	return result, err
+}
+
+func (p *policied[T]) Count() (int64, error) {
+	var count int64
+	err := p.policy.run(p.ctx, func(ctx context.Context) error {
+		var err error
+		count, err = p.Interface.WithContext(ctx).Count()
+		return err
+	})
+	return count, err
+}
+
+func (p *policied[T]) CountOf(ctx context.Context, col field.Columner) (int64, error) {
+	var count int64
+	err := p.policy.run(ctx, func(ctx context.Context) error {
+		var err error
+		count, err = p.Interface.CountOf(ctx, col)
+		return err
+	})
+	return count, err
+}
+
+func (p *policied[T]) CountDistinctOf(ctx context.Context, col field.Columner) (int64, error) {
+	var count int64
+	err := p.policy.run(ctx, func(ctx context.Context) error {
+		var err error
+		count, err = p.Interface.CountDistinctOf(ctx, col)
+		return err
+	})
+	return count, err
+}
+
+func (p *policied[T]) Exists(ctx context.Context) (bool, error) {
+	var exists bool
+	err := p.policy.run(ctx, func(ctx context.Context) error {
+		var err error
+		exists, err = p.Interface.Exists(ctx)
+		return err
+	})
+	return exists, err
+}
+
+func (p *policied[T]) Create(value *T) error {
+	return p.policy.run(p.ctx, func(ctx context.Context) error {
+		return p.Interface.WithContext(ctx).Create(value)
+	})
+}
+
+func (p *policied[T]) Save(value *T) error {
+	return p.policy.run(p.ctx, func(ctx context.Context) error {
+		return p.Interface.WithContext(ctx).Save(value)
+	})
+}
+
+func (p *policied[T]) Updates() (int64, error) {
+	var rows int64
+	err := p.policy.run(p.ctx, func(ctx context.Context) error {
+		var err error
+		rows, err = p.Interface.WithContext(ctx).Updates()
+		return err
+	})
+	return rows, err
+}
+
+func (p *policied[T]) Delete() (int64, error) {
+	var rows int64
+	err := p.policy.run(p.ctx, func(ctx context.Context) error {
+		var err error
+		rows, err = p.Interface.WithContext(ctx).Delete()
+		return err
+	})
+	return rows, err
+}
@@ -0,0 +1,143 @@
+package gen
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-gorm/cli/field"
+)
+
+// fakeFinder is a minimal Interface[testModel] stand-in: the embedded
+// nil Interface[testModel] satisfies every method WithPolicy's tests
+// below don't exercise, and Find/WithContext are overridden to
+// observe what WithPolicy actually did.
+type fakeFinder struct {
+	Interface[testModel]
+	calls     int
+	failUntil int
+	failErr   error
+	lastCtx   context.Context
+}
+
+func (f *fakeFinder) WithContext(ctx context.Context) Interface[testModel] {
+	f.lastCtx = ctx
+	return f
+}
+
+func (f *fakeFinder) Where(conds ...field.Expr) Interface[testModel] {
+	return f
+}
+
+func (f *fakeFinder) Find() ([]*testModel, error) {
+	f.calls++
+	if f.calls <= f.failUntil {
+		return nil, f.failErr
+	}
+	return []*testModel{{Name: "ok"}}, nil
+}
+
+func TestWithPolicyRetriesRetryableError(t *testing.T) {
+	f := &fakeFinder{failUntil: 2, failErr: errors.New("deadlock detected")}
+	q := WithPolicy[testModel](f, Policy{MaxAttempts: 3, Backoff: time.Millisecond})
+
+	results, err := q.Find()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 {
+		t.Errorf("results = %+v, want one row", results)
+	}
+	if f.calls != 3 {
+		t.Errorf("calls = %d, want 3 (two failures then a success)", f.calls)
+	}
+}
+
+func TestWithPolicyStopsOnNonRetryableError(t *testing.T) {
+	f := &fakeFinder{failUntil: 1, failErr: errors.New("column does not exist")}
+	q := WithPolicy[testModel](f, Policy{MaxAttempts: 3, Backoff: time.Millisecond})
+
+	if _, err := q.Find(); err == nil {
+		t.Fatal("want the non-retryable error surfaced")
+	}
+	if f.calls != 1 {
+		t.Errorf("calls = %d, want 1 (no retry for a non-retryable error)", f.calls)
+	}
+}
+
+func TestWithPolicyExhaustsAttempts(t *testing.T) {
+	f := &fakeFinder{failUntil: 5, failErr: errors.New("database is locked")}
+	q := WithPolicy[testModel](f, Policy{MaxAttempts: 3, Backoff: time.Millisecond})
+
+	if _, err := q.Find(); err == nil {
+		t.Fatal("want the last attempt's error surfaced after exhausting retries")
+	}
+	if f.calls != 3 {
+		t.Errorf("calls = %d, want 3 (MaxAttempts, no retry past it)", f.calls)
+	}
+}
+
+func TestWithPolicyAppliesTimeoutDeadline(t *testing.T) {
+	f := &fakeFinder{}
+	q := WithPolicy[testModel](f, Policy{Timeout: time.Minute})
+
+	if _, err := q.Find(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := f.lastCtx.Deadline(); !ok {
+		t.Error("want the attempt's context to carry a deadline when Timeout is set")
+	}
+}
+
+func TestWithPolicyChainPreservesPolicy(t *testing.T) {
+	f := &fakeFinder{failUntil: 1, failErr: errors.New("deadlock detected")}
+	q := WithPolicy[testModel](f, Policy{MaxAttempts: 2, Backoff: time.Millisecond}).Where(nameEq("bob"))
+
+	if _, err := q.Find(); err != nil {
+		t.Fatal(err)
+	}
+	if f.calls != 2 {
+		t.Errorf("calls = %d, want 2 (policy survives a chained call)", f.calls)
+	}
+}
+
+type ctxKey string
+
+func TestWithPolicyHonorsPriorWithContext(t *testing.T) {
+	f := &fakeFinder{}
+	userCtx, cancel := context.WithCancel(context.WithValue(context.Background(), ctxKey("k"), "v"))
+	defer cancel()
+
+	q := WithPolicy[testModel](f, Policy{MaxAttempts: 2}).WithContext(userCtx)
+
+	if _, err := q.Find(); err != nil {
+		t.Fatal(err)
+	}
+	if got := f.lastCtx.Value(ctxKey("k")); got != "v" {
+		t.Errorf("lastCtx value = %v, want the value carried on the caller's WithContext ctx", got)
+	}
+
+	cancel()
+	if f.lastCtx.Err() != context.Canceled {
+		t.Error("want a later attempt's ctx to observe cancellation of the caller's WithContext ctx, not context.Background()")
+	}
+}
+
+func TestIsRetryableError(t *testing.T) {
+	cases := []struct {
+		err  error
+		want bool
+	}{
+		{nil, false},
+		{errors.New("deadlock detected"), true},
+		{errors.New("ERROR: could not serialize access: serialization failure"), true},
+		{errors.New("database is locked"), true},
+		{errors.New("no such table: users"), false},
+	}
+	for _, c := range cases {
+		if got := isRetryableError(c.err); got != c.want {
+			t.Errorf("isRetryableError(%v) = %v, want %v", c.err, got, c.want)
+		}
+	}
+}
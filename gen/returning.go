@@ -0,0 +1,55 @@
+package gen
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/go-gorm/cli/field"
+)
+
+// dialectsWithoutReturning lists dialects whose driver doesn't add
+// RETURNING support to gorm's delete/update clause builders, so
+// DeleteReturning/UpdateReturning can fail clearly instead of silently
+// returning no rows.
+var dialectsWithoutReturning = map[string]bool{
+	"mysql": true,
+}
+
+// DeleteReturning deletes rows matching the current chain and returns
+// them, using RETURNING on dialects that support it. On dialects
+// without RETURNING support it returns an error rather than silently
+// falling back to a separate select.
+func (q *g[T]) DeleteReturning(ctx context.Context) ([]T, error) {
+	if name := q.db.Dialector.Name(); dialectsWithoutReturning[name] {
+		return nil, fmt.Errorf("gen: %s does not support DELETE ... RETURNING", name)
+	}
+
+	var model T
+	var results []T
+	db := q.db.WithContext(ctx)
+	if _, staged := db.Statement.Clauses["RETURNING"]; !staged {
+		db = db.Clauses(clause.Returning{})
+	}
+	err := db.Model(&model).Delete(&results).Error
+	return results, err
+}
+
+// UpdateReturning applies assignments to rows matching the current
+// chain and returns the updated rows, using RETURNING on dialects that
+// support it. On dialects without RETURNING support it returns an
+// error rather than silently falling back to a separate select.
+func (q *g[T]) UpdateReturning(ctx context.Context, assignments ...field.Assignment) ([]T, error) {
+	if name := q.db.Dialector.Name(); dialectsWithoutReturning[name] {
+		return nil, fmt.Errorf("gen: %s does not support UPDATE ... RETURNING", name)
+	}
+
+	var results []T
+	db := q.db.WithContext(ctx)
+	if _, staged := db.Statement.Clauses["RETURNING"]; !staged {
+		db = db.Clauses(clause.Returning{})
+	}
+	err := db.Model(&results).Clauses(clause.Set(assignments)).Updates(map[string]interface{}{}).Error
+	return results, err
+}
@@ -0,0 +1,66 @@
+package gen
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-gorm/cli/field"
+)
+
+func TestDeleteReturning(t *testing.T) {
+	db := newTestDB(t)
+	q := Use[testModel](db)
+	if err := q.Create(&testModel{Name: "bob"}); err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := Use[testModel](db).Where(nameEq("bob")).DeleteReturning(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || results[0].Name != "bob" {
+		t.Errorf("results = %+v, want one bob", results)
+	}
+
+	count, err := q.Count()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 0 {
+		t.Errorf("count = %d, want 0 after delete", count)
+	}
+}
+
+func TestDeleteReturningWithReturningColumns(t *testing.T) {
+	db := newTestDB(t)
+	q := Use[testModel](db)
+	if err := q.Create(&testModel{Name: "bob"}); err != nil {
+		t.Fatal(err)
+	}
+
+	name := field.NewField("test_models", "name")
+	results, err := Use[testModel](db).Where(nameEq("bob")).Returning(name).DeleteReturning(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || results[0].Name != "bob" {
+		t.Errorf("results = %+v, want one bob", results)
+	}
+}
+
+func TestUpdateReturning(t *testing.T) {
+	db := newTestDB(t)
+	q := Use[testModel](db)
+	if err := q.Create(&testModel{Name: "bob"}); err != nil {
+		t.Fatal(err)
+	}
+
+	name := field.NewField("test_models", "name")
+	results, err := Use[testModel](db).Where(nameEq("bob")).UpdateReturning(context.Background(), name.SetExpr(field.ExprOf[string]("?", "bobby")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || results[0].Name != "bobby" {
+		t.Errorf("results = %+v, want one bobby", results)
+	}
+}
@@ -0,0 +1,86 @@
+package gen
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ColumnAlias maps a SQL select expression to the destination struct
+// field ScanInto projects it into.
+type ColumnAlias struct {
+	Expr  string
+	Field string
+}
+
+// Col builds a ColumnAlias that selects column verbatim into field.
+func Col(column, field string) ColumnAlias {
+	return ColumnAlias{Expr: column, Field: field}
+}
+
+// ScanInto runs src's query projected through mapping and scans the
+// results into R. Each mapping's Field is validated against R's struct
+// fields before the query runs, so a typo'd projection fails loudly
+// instead of silently leaving that field zeroed.
+//
+// opts apply only to this call, not to src's chain, so an annotated
+// method generated to call ScanInto can forward its own trailing
+// opts ...clause.Expression parameter and let a caller add a locking
+// clause, an optimizer hint, or a SQL comment to that one invocation
+// without touching the annotation that produced it.
+func ScanInto[R any](ctx context.Context, src interface{ UnderlyingDB() *gorm.DB }, mapping []ColumnAlias, opts ...clause.Expression) ([]R, error) {
+	var dest R
+	t := reflect.TypeOf(dest)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	selects := make([]string, len(mapping))
+	for i, m := range mapping {
+		if _, ok := t.FieldByName(m.Field); !ok {
+			return nil, fmt.Errorf("gen: ScanInto: %s has no field %q", t, m.Field)
+		}
+		selects[i] = fmt.Sprintf("%s AS %s", m.Expr, m.Field)
+	}
+
+	db := src.UnderlyingDB()
+	if len(opts) > 0 {
+		db = db.Clauses(opts...)
+	}
+
+	var results []R
+	err := db.WithContext(ctx).Select(strings.Join(selects, ", ")).Scan(&results).Error
+	return results, err
+}
+
+// ScanMap runs src's query and scans the single result row into a map
+// keyed by column name, for an annotated method declared to return
+// (map[string]any, error) - an ad-hoc reporting query where defining a
+// result struct is overkill. opts apply only to this call; see ScanInto.
+func ScanMap(ctx context.Context, src interface{ UnderlyingDB() *gorm.DB }, opts ...clause.Expression) (map[string]any, error) {
+	db := src.UnderlyingDB()
+	if len(opts) > 0 {
+		db = db.Clauses(opts...)
+	}
+
+	result := map[string]any{}
+	err := db.WithContext(ctx).Scan(&result).Error
+	return result, err
+}
+
+// ScanMaps is ScanMap for an annotated method declared to return
+// ([]map[string]any, error).
+func ScanMaps(ctx context.Context, src interface{ UnderlyingDB() *gorm.DB }, opts ...clause.Expression) ([]map[string]any, error) {
+	db := src.UnderlyingDB()
+	if len(opts) > 0 {
+		db = db.Clauses(opts...)
+	}
+
+	var results []map[string]any
+	err := db.WithContext(ctx).Scan(&results).Error
+	return results, err
+}
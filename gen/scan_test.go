@@ -0,0 +1,89 @@
+package gen
+
+import (
+	"context"
+	"testing"
+
+	"gorm.io/hints"
+)
+
+type testModelProjection struct {
+	DisplayName string
+}
+
+func TestScanInto(t *testing.T) {
+	db := newTestDB(t)
+	q := Use[testModel](db)
+	if err := q.Create(&testModel{Name: "bob"}); err != nil {
+		t.Fatal(err)
+	}
+
+	src := &g[testModel]{db: Use[testModel](db).UnderlyingDB().Model(&testModel{})}
+	results, err := ScanInto[testModelProjection](context.Background(), src, []ColumnAlias{Col("name", "DisplayName")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || results[0].DisplayName != "bob" {
+		t.Errorf("results = %+v, want one {DisplayName: bob}", results)
+	}
+}
+
+func TestScanIntoUnknownField(t *testing.T) {
+	db := newTestDB(t)
+
+	_, err := ScanInto[testModelProjection](context.Background(), Use[testModel](db), []ColumnAlias{Col("name", "NoSuchField")})
+	if err == nil {
+		t.Fatal("want error for unknown destination field, got nil")
+	}
+}
+
+func TestScanIntoWithOpts(t *testing.T) {
+	db := newTestDB(t)
+	q := Use[testModel](db)
+	if err := q.Create(&testModel{Name: "bob"}); err != nil {
+		t.Fatal(err)
+	}
+
+	src := &g[testModel]{db: Use[testModel](db).UnderlyingDB().Model(&testModel{})}
+	results, err := ScanInto[testModelProjection](context.Background(), src, []ColumnAlias{Col("name", "DisplayName")}, hints.CommentBefore("select", "traced"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || results[0].DisplayName != "bob" {
+		t.Errorf("results = %+v, want one {DisplayName: bob}", results)
+	}
+}
+
+func TestScanMaps(t *testing.T) {
+	db := newTestDB(t)
+	q := Use[testModel](db)
+	if err := q.Create(&testModel{Name: "bob"}); err != nil {
+		t.Fatal(err)
+	}
+
+	src := &g[testModel]{db: Use[testModel](db).UnderlyingDB().Model(&testModel{}).Select("name")}
+	results, err := ScanMaps(context.Background(), src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || results[0]["name"] != "bob" {
+		t.Errorf("results = %+v, want one {name: bob}", results)
+	}
+}
+
+func TestScanMap(t *testing.T) {
+	db := newTestDB(t)
+	q := Use[testModel](db)
+	if err := q.Create(&testModel{Name: "bob"}); err != nil {
+		t.Fatal(err)
+	}
+
+	src := &g[testModel]{db: Use[testModel](db).UnderlyingDB().Model(&testModel{}).Select("name").Limit(1)}
+	result, err := ScanMap(context.Background(), src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result["name"] != "bob" {
+		t.Errorf("result = %+v, want {name: bob}", result)
+	}
+}
@@ -0,0 +1,20 @@
+package gen
+
+import "gorm.io/gorm"
+
+// Scopes applies raw gorm scopes (func(*gorm.DB) *gorm.DB) to the chain,
+// so scopes shared with code that hasn't migrated to Interface[T] keep
+// working unchanged.
+func (q *g[T]) Scopes(funcs ...func(*gorm.DB) *gorm.DB) Interface[T] {
+	return &g[T]{db: q.db.Scopes(funcs...)}
+}
+
+// Scope adapts a typed scope, written against Interface[T], into a raw
+// gorm scope usable with *gorm.DB.Scopes, so a scope authored against the
+// generics wrapper can still be applied from code that hasn't migrated
+// off raw gorm.
+func Scope[T any](fn func(Interface[T]) Interface[T]) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return fn(&g[T]{db: db}).UnderlyingDB()
+	}
+}
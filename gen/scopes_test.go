@@ -0,0 +1,56 @@
+package gen
+
+import (
+	"testing"
+
+	"gorm.io/gorm"
+)
+
+func activeOnly(db *gorm.DB) *gorm.DB {
+	return db.Where("active = ?", true)
+}
+
+func nameEqScope[T any](name string) func(Interface[T]) Interface[T] {
+	return func(q Interface[T]) Interface[T] {
+		return q.Where(nameEq(name))
+	}
+}
+
+func TestScopesRawIntoTyped(t *testing.T) {
+	db := newTestDB(t)
+	q := Use[testModel](db)
+	if err := q.Create(&testModel{Name: "bob", Active: true}); err != nil {
+		t.Fatal(err)
+	}
+	if err := q.Create(&testModel{Name: "ann", Active: false}); err != nil {
+		t.Fatal(err)
+	}
+
+	found, err := Use[testModel](db).Scopes(activeOnly).Find()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(found) != 1 || found[0].Name != "bob" {
+		t.Errorf("found = %+v, want one bob", found)
+	}
+}
+
+func TestScopeTypedIntoRaw(t *testing.T) {
+	db := newTestDB(t)
+	q := Use[testModel](db)
+	if err := q.Create(&testModel{Name: "bob"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := q.Create(&testModel{Name: "ann"}); err != nil {
+		t.Fatal(err)
+	}
+
+	var results []*testModel
+	err := db.Scopes(Scope(nameEqScope[testModel]("bob"))).Find(&results).Error
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || results[0].Name != "bob" {
+		t.Errorf("results = %+v, want one bob", results)
+	}
+}
@@ -0,0 +1,26 @@
+package gen
+
+import (
+	"testing"
+
+	"github.com/go-gorm/cli/field"
+)
+
+func TestSelectExcept(t *testing.T) {
+	db := newTestDB(t)
+	q := Use[testModel](db)
+	if err := q.Create(&testModel{Name: "bob", Active: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := q.SelectExcept(field.NewField("test_models", "active")).First()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Name != "bob" {
+		t.Errorf("Name = %q, want bob", result.Name)
+	}
+	if result.Active {
+		t.Errorf("Active = true, want false: excluded column should not have been loaded")
+	}
+}
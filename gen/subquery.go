@@ -0,0 +1,26 @@
+package gen
+
+import "gorm.io/gorm"
+
+// Subquery is a typed chain captured for reuse as a derived table or an
+// IN-subquery, returned by Interface[T].AsSubquery.
+type Subquery struct {
+	db    *gorm.DB
+	alias string
+}
+
+// Alias returns the name this subquery is aliased as.
+func (s Subquery) Alias() string {
+	return s.alias
+}
+
+// UnderlyingDB exposes the wrapped *gorm.DB, for passing as a `?` arg to
+// Table, Joins, or field.Field.In.
+func (s Subquery) UnderlyingDB() *gorm.DB {
+	return s.db
+}
+
+func (q *g[T]) AsSubquery(alias string) Subquery {
+	var model T
+	return Subquery{db: q.db.Model(&model), alias: alias}
+}
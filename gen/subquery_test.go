@@ -0,0 +1,33 @@
+package gen
+
+import (
+	"testing"
+
+	"github.com/go-gorm/cli/field"
+)
+
+func TestAsSubqueryIn(t *testing.T) {
+	db := newTestDB(t)
+	q := Use[testModel](db)
+	if err := q.Create(&testModel{Name: "bob", Active: true}); err != nil {
+		t.Fatal(err)
+	}
+	if err := q.Create(&testModel{Name: "ann", Active: false}); err != nil {
+		t.Fatal(err)
+	}
+
+	active := Use[testModel](db).Where(field.NewBool("test_models", "active").IsTrue())
+	sub := active.AsSubquery("active_models")
+	if sub.Alias() != "active_models" {
+		t.Errorf("alias = %q, want active_models", sub.Alias())
+	}
+
+	id := field.NewField("test_models", "id")
+	found, err := Use[testModel](db).Where(id.In(sub.UnderlyingDB().Select("id"))).Find()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(found) != 1 || found[0].Name != "bob" {
+		t.Errorf("found = %+v, want [bob]", found)
+	}
+}
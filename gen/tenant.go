@@ -0,0 +1,113 @@
+package gen
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/go-gorm/cli/field"
+)
+
+// ForTenant builds a scope restricting T to rows whose col equals
+// tenantID, the multi-tenant pattern of scoping every query by a
+// tenant/organization column. Combine with Scope to apply it from code
+// that hasn't migrated to Interface[T]:
+// db.Scopes(Scope(ForTenant[User](tenantIDColumn, id))).
+func ForTenant[T any](col field.Columner, tenantID interface{}) func(Interface[T]) Interface[T] {
+	return func(q Interface[T]) Interface[T] {
+		return q.Where(clause.Eq{Column: col.Column(), Value: tenantID})
+	}
+}
+
+// RequireTenantScope registers a guardrail on db that fails any
+// SELECT/UPDATE/DELETE whose WHERE clause doesn't reference column, so
+// a forgotten ForTenant scope on a multi-tenant model fails fast
+// instead of silently leaking rows across tenants. It has no effect on
+// Create, which has no WHERE clause to check.
+func RequireTenantScope(db *gorm.DB, column string) error {
+	check := func(db *gorm.DB) {
+		if !whereReferencesColumn(db.Statement.Clauses, column) {
+			db.AddError(fmt.Errorf("gen: query on %s missing required tenant scope on column %q", db.Statement.Table, column))
+		}
+	}
+	if err := db.Callback().Query().Before("gorm:query").Register("gen:require_tenant_scope_query", check); err != nil {
+		return err
+	}
+	if err := db.Callback().Update().Before("gorm:before_update").Register("gen:require_tenant_scope_update", check); err != nil {
+		return err
+	}
+	if err := db.Callback().Delete().Before("gorm:before_delete").Register("gen:require_tenant_scope_delete", check); err != nil {
+		return err
+	}
+	return nil
+}
+
+// whereReferencesColumn reports whether clauses' WHERE clause has a
+// top-level comparison against column, recursing through AND/OR
+// groups.
+func whereReferencesColumn(clauses map[string]clause.Clause, column string) bool {
+	c, ok := clauses["WHERE"]
+	if !ok {
+		return false
+	}
+	where, ok := c.Expression.(clause.Where)
+	if !ok {
+		return false
+	}
+	return exprsReferenceColumn(where.Exprs, column)
+}
+
+func exprsReferenceColumn(exprs []clause.Expression, column string) bool {
+	for _, e := range exprs {
+		switch v := e.(type) {
+		case clause.Eq:
+			if columnNamed(v.Column, column) {
+				return true
+			}
+		case clause.Neq:
+			if columnNamed(v.Column, column) {
+				return true
+			}
+		case clause.Gt:
+			if columnNamed(v.Column, column) {
+				return true
+			}
+		case clause.Gte:
+			if columnNamed(v.Column, column) {
+				return true
+			}
+		case clause.Lt:
+			if columnNamed(v.Column, column) {
+				return true
+			}
+		case clause.Lte:
+			if columnNamed(v.Column, column) {
+				return true
+			}
+		case clause.IN:
+			if columnNamed(v.Column, column) {
+				return true
+			}
+		case clause.AndConditions:
+			if exprsReferenceColumn(v.Exprs, column) {
+				return true
+			}
+		case clause.OrConditions:
+			if exprsReferenceColumn(v.Exprs, column) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func columnNamed(col interface{}, name string) bool {
+	switch c := col.(type) {
+	case string:
+		return c == name
+	case clause.Column:
+		return c.Name == name
+	}
+	return false
+}
@@ -0,0 +1,52 @@
+package gen
+
+import (
+	"testing"
+
+	"github.com/go-gorm/cli/field"
+)
+
+func TestForTenantScopesQuery(t *testing.T) {
+	db := newTestDB(t)
+	q := Use[testModel](db)
+	if err := q.Create(&testModel{Name: "bob"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := q.Create(&testModel{Name: "alice"}); err != nil {
+		t.Fatal(err)
+	}
+
+	nameCol := field.NewString("test_models", "name")
+	results, err := ForTenant[testModel](nameCol, "bob")(q).Find()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || results[0].Name != "bob" {
+		t.Errorf("results = %+v, want only bob", results)
+	}
+}
+
+func TestRequireTenantScopeRejectsUnscopedQuery(t *testing.T) {
+	db := newTestDB(t)
+	if err := RequireTenantScope(db, "name"); err != nil {
+		t.Fatal(err)
+	}
+
+	q := Use[testModel](db)
+	if _, err := q.Find(); err == nil {
+		t.Error("expected an error for a Find with no tenant scope")
+	}
+}
+
+func TestRequireTenantScopeAllowsScopedQuery(t *testing.T) {
+	db := newTestDB(t)
+	if err := RequireTenantScope(db, "name"); err != nil {
+		t.Fatal(err)
+	}
+
+	nameCol := field.NewString("test_models", "name")
+	q := ForTenant[testModel](nameCol, "bob")(Use[testModel](db))
+	if _, err := q.Find(); err != nil {
+		t.Errorf("err = %v, want nil for a scoped query", err)
+	}
+}
@@ -0,0 +1,17 @@
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// Transaction runs fn inside a database transaction, passing it a chain
+// scoped to that transaction so the callback never has to drop back to
+// *gorm.DB. The transaction is committed if fn returns nil, and rolled
+// back otherwise.
+func (q *g[T]) Transaction(ctx context.Context, fn func(tx Interface[T]) error) error {
+	return q.db.WithContext(ctx).Transaction(func(txDB *gorm.DB) error {
+		return fn(&g[T]{db: txDB})
+	})
+}
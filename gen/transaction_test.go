@@ -0,0 +1,51 @@
+package gen
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestTransactionCommits(t *testing.T) {
+	db := newTestDB(t)
+	q := Use[testModel](db)
+
+	err := q.Transaction(context.Background(), func(tx Interface[testModel]) error {
+		return tx.Create(&testModel{Name: "bob"})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := q.Count()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Errorf("count = %d, want 1", count)
+	}
+}
+
+func TestTransactionRollsBackOnError(t *testing.T) {
+	db := newTestDB(t)
+	q := Use[testModel](db)
+	wantErr := errors.New("boom")
+
+	err := q.Transaction(context.Background(), func(tx Interface[testModel]) error {
+		if err := tx.Create(&testModel{Name: "bob"}); err != nil {
+			return err
+		}
+		return wantErr
+	})
+	if err != wantErr {
+		t.Fatalf("err = %v, want %v", err, wantErr)
+	}
+
+	count, err := q.Count()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 0 {
+		t.Errorf("count = %d, want 0 after rollback", count)
+	}
+}
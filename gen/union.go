@@ -0,0 +1,44 @@
+package gen
+
+import (
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// UnionQuery combines several typed chains into one result set, built
+// via Union or UnionAll.
+type UnionQuery[T any] struct {
+	db *gorm.DB
+}
+
+// Union returns the distinct rows produced by any of qs, via SQL UNION.
+func Union[T any](qs ...Interface[T]) UnionQuery[T] {
+	return unionOf("UNION", qs)
+}
+
+// UnionAll returns every row produced by qs, duplicates included, via
+// SQL UNION ALL.
+func UnionAll[T any](qs ...Interface[T]) UnionQuery[T] {
+	return unionOf("UNION ALL", qs)
+}
+
+func unionOf[T any](op string, qs []Interface[T]) UnionQuery[T] {
+	parts := make([]string, len(qs))
+	vars := make([]interface{}, len(qs))
+	for i, q := range qs {
+		var model T
+		parts[i] = "?"
+		vars[i] = q.UnderlyingDB().Model(&model)
+	}
+	sql := strings.Join(parts, " "+op+" ")
+	db := qs[0].UnderlyingDB().Session(&gorm.Session{}).Raw(sql, vars...)
+	return UnionQuery[T]{db: db}
+}
+
+// Find runs the union and returns every resulting row.
+func (u UnionQuery[T]) Find() ([]*T, error) {
+	var results []*T
+	err := u.db.Scan(&results).Error
+	return results, err
+}
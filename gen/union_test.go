@@ -0,0 +1,47 @@
+package gen
+
+import "testing"
+
+func TestUnion(t *testing.T) {
+	db := newTestDB(t)
+	q := Use[testModel](db)
+	if err := q.Create(&testModel{Name: "bob"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := q.Create(&testModel{Name: "ann"}); err != nil {
+		t.Fatal(err)
+	}
+
+	bobs1 := Use[testModel](db).Where(nameEq("bob"))
+	bobs2 := Use[testModel](db).Where(nameEq("bob"))
+
+	results, err := Union(bobs1, bobs2).Find()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 {
+		t.Errorf("len(results) = %d, want 1 (UNION dedupes the identical row)", len(results))
+	}
+}
+
+func TestUnionAll(t *testing.T) {
+	db := newTestDB(t)
+	q := Use[testModel](db)
+	if err := q.Create(&testModel{Name: "bob"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := q.Create(&testModel{Name: "ann"}); err != nil {
+		t.Fatal(err)
+	}
+
+	bobs := Use[testModel](db).Where(nameEq("bob"))
+	anns := Use[testModel](db).Where(nameEq("ann"))
+
+	results, err := UnionAll(bobs, anns).Find()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 {
+		t.Errorf("len(results) = %d, want 2", len(results))
+	}
+}
@@ -0,0 +1,20 @@
+package gen
+
+// Validator is implemented by a model whose generated Validate method
+// checks its gorm tag constraints (not null, size, ...) client-side.
+// Create and Save call it before hitting the database, so a violation
+// comes back as an ordinary Go error instead of a driver-specific
+// constraint failure.
+type Validator interface {
+	Validate() error
+}
+
+// validate runs value's Validate method if it implements Validator,
+// returning nil for a value that doesn't.
+func validate(value interface{}) error {
+	v, ok := value.(Validator)
+	if !ok {
+		return nil
+	}
+	return v.Validate()
+}
@@ -0,0 +1,45 @@
+package gen
+
+import (
+	"errors"
+	"testing"
+)
+
+type validatedModel struct {
+	ID   uint
+	Name string
+}
+
+func (m *validatedModel) Validate() error {
+	if m.Name == "" {
+		return errors.New("name must not be empty")
+	}
+	return nil
+}
+
+func TestCreateRunsValidate(t *testing.T) {
+	db := newTestDB(t)
+	if err := db.AutoMigrate(&validatedModel{}); err != nil {
+		t.Fatal(err)
+	}
+
+	q := Use[validatedModel](db)
+	if err := q.Create(&validatedModel{}); err == nil {
+		t.Fatal("want an error for an invalid model")
+	}
+	if err := q.Create(&validatedModel{Name: "ok"}); err != nil {
+		t.Fatalf("want a valid model to create cleanly, got %v", err)
+	}
+}
+
+func TestSaveRunsValidate(t *testing.T) {
+	db := newTestDB(t)
+	if err := db.AutoMigrate(&validatedModel{}); err != nil {
+		t.Fatal(err)
+	}
+
+	q := Use[validatedModel](db)
+	if err := q.Save(&validatedModel{}); err == nil {
+		t.Fatal("want an error for an invalid model")
+	}
+}
@@ -0,0 +1,35 @@
+package gen
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-gorm/cli/field"
+)
+
+// ErrVersionConflict is returned by UpdateWithVersion when no row
+// matches the expected version, meaning some other writer already
+// updated or deleted the row since it was read.
+var ErrVersionConflict = errors.New("gen: version conflict")
+
+// UpdateWithVersion applies assignments to the single row of T whose
+// versionColumn still equals expectedVersion, incrementing
+// versionColumn as part of the same statement, the standard optimistic
+// locking pattern for a Version/LockVersion column. If the version has
+// moved - because another writer already updated or deleted the row -
+// no row matches and it returns ErrVersionConflict rather than a
+// misleadingly successful zero-rows result.
+func UpdateWithVersion[T any](ctx context.Context, q Interface[T], versionColumn field.Null[int64], expectedVersion int64, assignments ...field.Assignment) (int64, error) {
+	set := append(append([]field.Assignment{}, assignments...), versionColumn.SetExpr(field.ExprOf[int64]("? + 1", versionColumn)))
+	rows, err := q.WithContext(ctx).
+		Where(versionColumn.Eq(expectedVersion)).
+		Set(set...).
+		Updates()
+	if err != nil {
+		return 0, err
+	}
+	if rows == 0 {
+		return 0, ErrVersionConflict
+	}
+	return rows, nil
+}
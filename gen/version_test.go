@@ -0,0 +1,80 @@
+package gen
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-gorm/cli/field"
+)
+
+type versionedModel struct {
+	ID      uint
+	Name    string
+	Version int64
+}
+
+func versionColumn() field.Null[int64] {
+	return field.NewNull[int64]("versioned_models", "version")
+}
+
+func nameEqVersioned(name string) field.Expr {
+	return field.NewString("versioned_models", "name").Eq(name)
+}
+
+func TestUpdateWithVersionAppliesAndIncrements(t *testing.T) {
+	db := newTestDB(t)
+	if err := db.AutoMigrate(&versionedModel{}); err != nil {
+		t.Fatal(err)
+	}
+
+	q := Use[versionedModel](db)
+	m := &versionedModel{Name: "widget", Version: 1}
+	if err := q.Create(m); err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := UpdateWithVersion(context.Background(), q.Where(nameEqVersioned("widget")), versionColumn(), 1,
+		field.NewNull[string]("versioned_models", "name").Set("gadget"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rows != 1 {
+		t.Fatalf("rows = %d, want 1", rows)
+	}
+
+	result, err := q.Where(nameEqVersioned("gadget")).First()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Version != 2 {
+		t.Errorf("Version = %d, want 2", result.Version)
+	}
+}
+
+func TestUpdateWithVersionConflict(t *testing.T) {
+	db := newTestDB(t)
+	if err := db.AutoMigrate(&versionedModel{}); err != nil {
+		t.Fatal(err)
+	}
+
+	q := Use[versionedModel](db)
+	m := &versionedModel{Name: "widget", Version: 1}
+	if err := q.Create(m); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := UpdateWithVersion(context.Background(), q.Where(nameEqVersioned("widget")), versionColumn(), 5,
+		field.NewNull[string]("versioned_models", "name").Set("gadget"))
+	if !errors.Is(err, ErrVersionConflict) {
+		t.Fatalf("err = %v, want ErrVersionConflict", err)
+	}
+
+	result, err := q.Where(nameEqVersioned("widget")).First()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Version != 1 {
+		t.Errorf("Version = %d, want 1 (unchanged after conflict)", result.Version)
+	}
+}
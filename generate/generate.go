@@ -0,0 +1,75 @@
+// Package generate is a stable, in-process entry point into the same
+// generation engine behind `gorm gen`, for build tools (mage targets,
+// bazel rules, IDE plugins) that want to invoke generation from Go code
+// and get a structured result back, rather than shelling out to the
+// gorm binary and parsing its output.
+//
+// It isn't named gen because that name already belongs to this
+// module's other public package - the runtime query-building API
+// generated code imports - and reusing it here would conflate two
+// unrelated concerns: driving generation versus writing queries against
+// what it produced.
+package generate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-gorm/cli/internal/generator"
+)
+
+// Options configures a Run call: the equivalent of `gorm gen`'s
+// --dsn/--output flags and table-name arguments.
+type Options struct {
+	// DSN is the data source name of the database to generate from. Required.
+	DSN string
+	// Tables restricts generation to the named tables. Empty means
+	// every table in DSN, in the same order `gorm gen` would use.
+	Tables []string
+	// Output is the directory generated files are addressed relative
+	// to in Report.Outputs.
+	Output string
+}
+
+// Report is the structured result of a Run call - the same shape
+// `gorm gen --format json` prints, so a build tool sees identical
+// inputs, outputs, warnings and skips whichever path it drives
+// generation through.
+type Report = generator.Result
+
+// Run generates typed query code for opts.Tables (or every table in
+// opts.DSN if empty), returning a Report. Run checks ctx between
+// tables, so a caller wiring in its own timeout or cancellation can
+// stop a long run between units of work; it doesn't cancel a table
+// already in progress.
+func Run(ctx context.Context, opts Options) (Report, error) {
+	if opts.DSN == "" {
+		return Report{}, fmt.Errorf("generate: DSN is required")
+	}
+
+	if err := generator.CheckGormCompatibility(opts.Output); err != nil {
+		return Report{}, err
+	}
+
+	tables := opts.Tables
+	if len(tables) == 0 {
+		var err error
+		tables, err = generator.ListTables(opts.DSN)
+		if err != nil {
+			return Report{}, err
+		}
+	}
+
+	report := Report{Inputs: tables}
+	for _, table := range tables {
+		if err := ctx.Err(); err != nil {
+			return report, err
+		}
+		path, err := generator.WriteTable(table, opts.Output)
+		if err != nil {
+			return report, err
+		}
+		report.Outputs = append(report.Outputs, path)
+	}
+	return report, nil
+}
@@ -0,0 +1,91 @@
+package generate
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/go-gorm/cli/internal/generator"
+)
+
+// newTestOutputDir returns a temp directory with a go.mod requiring a
+// current gorm.io/gorm, so CheckGormCompatibility accepts it as an Output
+// target the way a real generated-code destination module would be.
+func newTestOutputDir(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	mod := "module gentest\n\ngo 1.22\n\nrequire gorm.io/gorm v1.25.12\n"
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte(mod), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func newTestSQLiteFile(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "test.db")
+	db, err := gorm.Open(sqlite.Open(path), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Exec("CREATE TABLE users (id INTEGER PRIMARY KEY)").Error; err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestRunGeneratesEveryTableByDefault(t *testing.T) {
+	dsn := newTestSQLiteFile(t)
+	output := newTestOutputDir(t)
+
+	report, err := Run(context.Background(), Options{DSN: dsn, Output: output})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Inputs) != 1 || report.Inputs[0] != "users" {
+		t.Errorf("Inputs = %v, want [users]", report.Inputs)
+	}
+	want := filepath.Join(output, "users_gen.go")
+	if len(report.Outputs) != 1 || report.Outputs[0] != want {
+		t.Errorf("Outputs = %v, want [%s]", report.Outputs, want)
+	}
+}
+
+func TestRunWritesGeneratedFile(t *testing.T) {
+	dsn := newTestSQLiteFile(t)
+	output := newTestOutputDir(t)
+
+	report, err := Run(context.Background(), Options{DSN: dsn, Output: output})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	written, err := os.ReadFile(report.Outputs[0])
+	if err != nil {
+		t.Fatalf("read %s: %v", report.Outputs[0], err)
+	}
+	if want := generator.Render("users"); string(written) != want {
+		t.Errorf("written content = %q, want %q", written, want)
+	}
+}
+
+func TestRunRequiresDSN(t *testing.T) {
+	if _, err := Run(context.Background(), Options{}); err == nil {
+		t.Fatal("want an error when Options.DSN is empty")
+	}
+}
+
+func TestRunRespectsCanceledContext(t *testing.T) {
+	dsn := newTestSQLiteFile(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := Run(ctx, Options{DSN: dsn}); err == nil {
+		t.Fatal("want an error when ctx is already canceled")
+	}
+}
@@ -0,0 +1,42 @@
+// Package generrors holds typed errors returned by gorm's code
+// generator (internal/generator) and its genconfig checks. It's a
+// package of its own, separate from field and gen, because it
+// describes generation-time failures - bad annotations, unsupported
+// column types - not anything about the runtime query API those
+// packages generate calls into. internal/generator can't be imported
+// directly since it's an internal package, but a tool driving
+// generation through the gorm binary (or a gorm-* plugin built against
+// its own copy of the generator) can still import generrors and use
+// errors.As to distinguish failure causes instead of matching error
+// text.
+package generrors
+
+import "fmt"
+
+// ErrInvalidAnnotation reports a genconfig check failure at a specific
+// method: an untyped Order call or a missing context.Context parameter,
+// depending on which internal/generator ValidateXxx check produced it.
+type ErrInvalidAnnotation struct {
+	Method string
+	Reason string
+}
+
+func (e *ErrInvalidAnnotation) Error() string {
+	return fmt.Sprintf("%s: %s", e.Method, e.Reason)
+}
+
+// ErrUnsupportedFieldType reports that a model column's Go type has no
+// corresponding typed field.Field wrapper registered for it. gen still
+// generates working code for these columns - it falls back to
+// field.String or field.Null[T] - so this isn't returned by the
+// default generation path; it's for stricter tools that want to fail
+// on an unrecognized column type instead of accepting the fallback.
+type ErrUnsupportedFieldType struct {
+	Table  string
+	Column string
+	GoType string
+}
+
+func (e *ErrUnsupportedFieldType) Error() string {
+	return fmt.Sprintf("%s.%s: unsupported field type %s", e.Table, e.Column, e.GoType)
+}
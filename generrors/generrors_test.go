@@ -0,0 +1,19 @@
+package generrors
+
+import "testing"
+
+func TestErrInvalidAnnotationMessage(t *testing.T) {
+	err := &ErrInvalidAnnotation{Method: "GetByID", Reason: "needs a context.Context parameter"}
+	want := "GetByID: needs a context.Context parameter"
+	if err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+}
+
+func TestErrUnsupportedFieldTypeMessage(t *testing.T) {
+	err := &ErrUnsupportedFieldType{Table: "orders", Column: "total", GoType: "money.Amount"}
+	want := "orders.total: unsupported field type money.Amount"
+	if err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+}
@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/go-gorm/cli/internal/generator"
+)
+
+// newGenBenchCmd builds `gorm gen bench`, which emits a companion
+// _bench_test.go per table with an Example and a few Benchmark stubs
+// exercising the table's generated Interface[T] accessor, behind a
+// gen_bench build tag so a team can track query-layer performance
+// regressions without the stubs running under a plain `go test ./...`.
+func newGenBenchCmd() *cobra.Command {
+	var dsn, output string
+
+	cmd := &cobra.Command{
+		Use:   "bench [tables...]",
+		Short: "Generate benchmark and example stubs for one or more tables",
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if dsn == "" {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			tables, err := listTables(dsn)
+			if err != nil {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			return tables, cobra.ShellCompDirectiveNoFileComp
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if dsn == "" {
+				return errWithCode(ExitUsageError, fmt.Errorf("bench: --dsn is required"))
+			}
+
+			tables := args
+			if len(tables) == 0 {
+				var err error
+				tables, err = listTables(dsn)
+				if err != nil {
+					return errWithCode(ExitGenerationError, err)
+				}
+			}
+
+			for _, table := range tables {
+				code := generator.RenderBenchmarks(table)
+				path := filepath.Join(output, table+"_bench_test.go")
+				if err := os.WriteFile(path, []byte(code), 0o644); err != nil {
+					return errWithCode(ExitGenerationError, err)
+				}
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&dsn, "dsn", "", "data source name of the database to generate from")
+	cmd.Flags().StringVarP(&output, "output", "o", ".", "directory to write generated benchmark files to")
+	cmd.MarkFlagDirname("output")
+
+	return cmd
+}
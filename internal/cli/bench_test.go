@@ -0,0 +1,39 @@
+package cli
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGenBenchWritesFile(t *testing.T) {
+	dsn := newTestSQLiteFileWithSchema(t)
+	dir := t.TempDir()
+
+	root := NewRootCmd()
+	root.SetArgs([]string{"gen", "bench", "--dsn", dsn, "--output", dir, "users"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "users_bench_test.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(data, []byte("//go:build gen_bench")) {
+		t.Errorf("file contents = %q, want a gen_bench build tag", data)
+	}
+	if !bytes.Contains(data, []byte("func BenchmarkUsers_First")) {
+		t.Errorf("file contents = %q, want a BenchmarkUsers_First", data)
+	}
+}
+
+func TestGenBenchRequiresDSN(t *testing.T) {
+	root := NewRootCmd()
+	root.SetArgs([]string{"gen", "bench"})
+	if err := root.Execute(); err == nil {
+		t.Fatal("want an error when --dsn is not set")
+	}
+}
@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"io"
+	"os"
+
+	"github.com/fatih/color"
+	"github.com/mattn/go-isatty"
+
+	"github.com/spf13/cobra"
+)
+
+// colorEnabled reports whether out should receive ANSI color codes:
+// attached to a real terminal, NO_COLOR unset, and --no-color not
+// passed. It never colorizes a non-*os.File writer (a bytes.Buffer in
+// tests, a pipe, a redirected file), matching the NO_COLOR convention
+// of erring towards plain text whenever in doubt.
+func colorEnabled(out io.Writer, noColor bool) bool {
+	if noColor || os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+	f, ok := out.(*os.File)
+	if !ok {
+		return false
+	}
+	return isatty.IsTerminal(f.Fd()) || isatty.IsCygwinTerminal(f.Fd())
+}
+
+// painter renders status/diff output, colorizing it only when
+// colorEnabled says the destination is a real terminal. Build one with
+// newPainter per command invocation rather than relying on fatih/color's
+// global state, so output is deterministic under tests and redirection.
+type painter struct {
+	ok, fail, warn *color.Color
+}
+
+func newPainter(cmd *cobra.Command, noColor bool) *painter {
+	enabled := colorEnabled(cmd.OutOrStdout(), noColor)
+
+	p := &painter{
+		ok:   color.New(color.FgGreen),
+		fail: color.New(color.FgRed),
+		warn: color.New(color.FgYellow),
+	}
+	if !enabled {
+		p.ok.DisableColor()
+		p.fail.DisableColor()
+		p.warn.DisableColor()
+	}
+	return p
+}
+
+func (p *painter) OK(s string) string   { return p.ok.Sprint(s) }
+func (p *painter) Fail(s string) string { return p.fail.Sprint(s) }
+func (p *painter) Warn(s string) string { return p.warn.Sprint(s) }
@@ -0,0 +1,33 @@
+package cli
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+func TestColorEnabled(t *testing.T) {
+	if colorEnabled(&bytes.Buffer{}, false) {
+		t.Error("want color disabled for a non-*os.File writer")
+	}
+	if colorEnabled(os.Stdout, true) {
+		t.Error("want color disabled when noColor is true, even for a *os.File")
+	}
+	t.Setenv("NO_COLOR", "1")
+	if colorEnabled(os.Stdout, false) {
+		t.Error("want color disabled when NO_COLOR is set")
+	}
+}
+
+func TestDoctorOutputHasNoEscapesWithoutTTY(t *testing.T) {
+	root := NewRootCmd()
+	var out bytes.Buffer
+	root.SetOut(&out)
+	root.SetArgs([]string{"doctor"})
+
+	_ = root.Execute()
+
+	if bytes.ContainsRune(out.Bytes(), '\x1b') {
+		t.Errorf("output = %q, want no ANSI escapes when writing to a buffer", out.String())
+	}
+}
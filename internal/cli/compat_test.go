@@ -0,0 +1,25 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestGenFailsOnIncompatibleGormVersion(t *testing.T) {
+	dsn := newTestSQLiteFile(t)
+	dir := t.TempDir()
+	writeTestGoMod(t, dir, "v1.20.0")
+
+	root := NewRootCmd()
+	var out bytes.Buffer
+	root.SetOut(&out)
+	root.SetArgs([]string{"gen", "--dsn", dsn, "--output", dir, "users"})
+
+	err := root.Execute()
+	if err == nil {
+		t.Fatal("want an error when the target module's gorm is too old")
+	}
+	if got := ExitCode(err); got != ExitGenerationError {
+		t.Errorf("ExitCode = %d, want %d", got, ExitGenerationError)
+	}
+}
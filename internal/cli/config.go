@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// newViper builds the viper instance backing --config and GORM_* env
+// var overrides for every subcommand's flags, so CI pipelines can
+// configure the tool without long command lines.
+func newViper() *viper.Viper {
+	v := viper.New()
+	v.SetEnvPrefix("GORM")
+	v.SetEnvKeyReplacer(strings.NewReplacer("-", "_"))
+	v.AutomaticEnv()
+	return v
+}
+
+// bindConfigFlag registers --config on root and, once cobra parses
+// flags, loads it into v and binds every flag of every subcommand to v
+// so unset flags fall back to the config file or a GORM_* env var.
+//
+// setupContext, if non-nil, runs first and gets a chance to replace
+// cmd's context (see NewRootCmd's --timeout/signal handling) before
+// the command's own RunE sees it. It lives here, rather than as its
+// own PersistentPreRunE, because cobra only invokes the closest
+// PersistentPreRun/E in the command chain - defining a second one on
+// root would silently discard this one.
+func bindConfigFlag(root *cobra.Command, v *viper.Viper, setupContext func(cmd *cobra.Command)) {
+	var configFile string
+	root.PersistentFlags().StringVar(&configFile, "config", "", "path to a config file (YAML, TOML, or JSON)")
+
+	root.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		if setupContext != nil {
+			setupContext(cmd)
+		}
+
+		if configFile != "" {
+			v.SetConfigFile(configFile)
+			if err := v.ReadInConfig(); err != nil {
+				return err
+			}
+		}
+
+		cmd.Flags().VisitAll(func(f *pflag.Flag) {
+			if !f.Changed && v.IsSet(f.Name) {
+				_ = cmd.Flags().Set(f.Name, v.GetString(f.Name))
+			}
+		})
+		return nil
+	}
+}
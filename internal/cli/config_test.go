@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func TestConfigFileOverridesFlag(t *testing.T) {
+	dir := t.TempDir()
+	cfgPath := filepath.Join(dir, "gorm.yaml")
+	if err := os.WriteFile(cfgPath, []byte("dsn: from-config.db\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	root := NewRootCmd()
+	root.SetArgs([]string{"gen", "--config", cfgPath})
+	root.SetOut(nil)
+
+	var gotDSN string
+	genCmd, _, err := root.Find([]string{"gen"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	genCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		gotDSN, _ = cmd.Flags().GetString("dsn")
+		return nil
+	}
+
+	if err := root.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if gotDSN != "from-config.db" {
+		t.Errorf("dsn = %q, want %q", gotDSN, "from-config.db")
+	}
+}
+
+func TestEnvVarOverridesFlag(t *testing.T) {
+	t.Setenv("GORM_DSN", "from-env.db")
+
+	root := NewRootCmd()
+	root.SetArgs([]string{"gen"})
+
+	var gotDSN string
+	genCmd, _, err := root.Find([]string{"gen"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	genCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		gotDSN, _ = cmd.Flags().GetString("dsn")
+		return nil
+	}
+
+	if err := root.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if gotDSN != "from-env.db" {
+		t.Errorf("dsn = %q, want %q", gotDSN, "from-env.db")
+	}
+}
@@ -0,0 +1,47 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// TableDiff is the diff between a table's currently committed generated
+// file and what `gorm gen` would write for it now.
+type TableDiff struct {
+	Table   string `json:"table"`
+	Path    string `json:"path"`
+	Diff    string `json:"diff,omitempty"`
+	Changed bool   `json:"changed"`
+}
+
+// diffTable compares want against whatever is currently on disk at path,
+// without writing anything. A missing file diffs against an empty
+// "before", so a brand-new table shows as an addition rather than an error.
+func diffTable(table, path, want string) (TableDiff, error) {
+	existing, err := os.ReadFile(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return TableDiff{}, err
+		}
+		existing = nil
+	}
+
+	if string(existing) == want {
+		return TableDiff{Table: table, Path: path}, nil
+	}
+
+	unified := difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(existing)),
+		B:        difflib.SplitLines(want),
+		FromFile: path,
+		ToFile:   fmt.Sprintf("%s (generated)", path),
+		Context:  3,
+	}
+	text, err := difflib.GetUnifiedDiffString(unified)
+	if err != nil {
+		return TableDiff{}, err
+	}
+	return TableDiff{Table: table, Path: path, Diff: text, Changed: true}, nil
+}
@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-gorm/cli/internal/generator"
+)
+
+func writeTestGoMod(t *testing.T, dir, gormVersion string) {
+	t.Helper()
+	contents := "module diffexample\n\ngo 1.22\n\nrequire gorm.io/gorm " + gormVersion + "\n"
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte(contents), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDiffTableNewFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "users_gen.go")
+
+	d, err := diffTable("users", path, generator.Render("users"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !d.Changed {
+		t.Error("want Changed for a file that doesn't exist yet")
+	}
+	if !bytes.Contains([]byte(d.Diff), []byte("+package query")) {
+		t.Errorf("Diff = %q, want an addition for the new package line", d.Diff)
+	}
+}
+
+func TestDiffTableUpToDate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "users_gen.go")
+	want := generator.Render("users")
+	if err := os.WriteFile(path, []byte(want), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	d, err := diffTable("users", path, want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Changed || d.Diff != "" {
+		t.Errorf("diffTable = %+v, want no changes when the file already matches", d)
+	}
+}
+
+func TestGenDiffDetectsDrift(t *testing.T) {
+	dsn := newTestSQLiteFile(t)
+
+	root := NewRootCmd()
+	var out bytes.Buffer
+	root.SetOut(&out)
+	root.SetArgs([]string{"gen", "--dsn", dsn, "--diff", "users"})
+
+	err := root.Execute()
+	if err == nil {
+		t.Fatal("want an error signaling drift when no generated file exists yet")
+	}
+	if got := ExitCode(err); got != ExitDriftDetected {
+		t.Errorf("ExitCode = %d, want %d", got, ExitDriftDetected)
+	}
+	if !bytes.Contains(out.Bytes(), []byte("package query")) {
+		t.Errorf("output = %q, want the diff to include the generated content", out.String())
+	}
+}
+
+func TestGenDiffCleanWhenUpToDate(t *testing.T) {
+	dsn := newTestSQLiteFile(t)
+	dir := t.TempDir()
+	writeTestGoMod(t, dir, "v1.25.12")
+	if err := os.WriteFile(filepath.Join(dir, "users_gen.go"), []byte(generator.Render("users")), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	root := NewRootCmd()
+	var out bytes.Buffer
+	root.SetOut(&out)
+	root.SetArgs([]string{"gen", "--dsn", dsn, "--diff", "--output", dir, "users"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("want no error when the generated file is up to date, got %v", err)
+	}
+	if out.Len() != 0 {
+		t.Errorf("output = %q, want no diff printed when up to date", out.String())
+	}
+}
+
+func TestGenDiffJSONFormat(t *testing.T) {
+	dsn := newTestSQLiteFile(t)
+
+	root := NewRootCmd()
+	var out bytes.Buffer
+	root.SetOut(&out)
+	root.SetArgs([]string{"gen", "--dsn", dsn, "--diff", "--format", "json", "users"})
+
+	_ = root.Execute()
+
+	var diffs []TableDiff
+	if err := json.Unmarshal(out.Bytes(), &diffs); err != nil {
+		t.Fatalf("unmarshal %q: %v", out.String(), err)
+	}
+	if len(diffs) != 1 || diffs[0].Table != "users" || !diffs[0].Changed {
+		t.Errorf("diffs = %+v, want one changed entry for users", diffs)
+	}
+}
@@ -0,0 +1,162 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// discoverDSN looks for a local database connection in dir when the
+// user hasn't configured one, so `migrate` commands need zero
+// connection plumbing for the common local-dev case: it checks the
+// DATABASE_URL environment variable, then a .env file, then a
+// docker-compose file's first Postgres/MySQL service, in that order.
+// It reports where the DSN came from so callers can ask for
+// confirmation before using it.
+func discoverDSN(dir string) (dsn, source string, ok bool) {
+	if v := os.Getenv("DATABASE_URL"); v != "" {
+		return v, "environment variable DATABASE_URL", true
+	}
+
+	if v, ok := readDotEnvDSN(filepath.Join(dir, ".env")); ok {
+		return v, ".env", true
+	}
+
+	for _, name := range []string{"docker-compose.yml", "docker-compose.yaml"} {
+		path := filepath.Join(dir, name)
+		if v, ok := readComposeDSN(path); ok {
+			return v, name, true
+		}
+	}
+
+	return "", "", false
+}
+
+// readDotEnvDSN reads a simple KEY=VALUE .env file looking for
+// DATABASE_URL, without any of the quoting/export/interpolation rules a
+// full .env parser would support.
+func readDotEnvDSN(path string) (string, bool) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", false
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		key, value, found := strings.Cut(line, "=")
+		if !found || strings.TrimSpace(key) != "DATABASE_URL" {
+			continue
+		}
+		return strings.Trim(strings.TrimSpace(value), `"'`), true
+	}
+	return "", false
+}
+
+// composeFile is the subset of docker-compose.yml this package reads.
+type composeFile struct {
+	Services map[string]composeService `yaml:"services"`
+}
+
+type composeService struct {
+	Image       string    `yaml:"image"`
+	Ports       []string  `yaml:"ports"`
+	Environment yaml.Node `yaml:"environment"`
+}
+
+// readComposeDSN parses path as a docker-compose file and builds a DSN
+// from the first service whose image looks like Postgres or MySQL.
+func readComposeDSN(path string) (string, bool) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", false
+	}
+	var compose composeFile
+	if err := yaml.Unmarshal(data, &compose); err != nil {
+		return "", false
+	}
+
+	for _, name := range sortedServiceNames(compose.Services) {
+		svc := compose.Services[name]
+		image := strings.ToLower(svc.Image)
+		env := composeEnvironment(svc.Environment)
+		port := composeHostPort(svc.Ports)
+
+		switch {
+		case strings.Contains(image, "postgres"):
+			user := firstNonEmpty(env["POSTGRES_USER"], "postgres")
+			password := env["POSTGRES_PASSWORD"]
+			db := firstNonEmpty(env["POSTGRES_DB"], user)
+			if port == "" {
+				port = "5432"
+			}
+			return fmt.Sprintf("postgres://%s:%s@localhost:%s/%s?sslmode=disable", user, password, port, db), true
+		case strings.Contains(image, "mysql"), strings.Contains(image, "mariadb"):
+			user := firstNonEmpty(env["MYSQL_USER"], "root")
+			password := firstNonEmpty(env["MYSQL_PASSWORD"], env["MYSQL_ROOT_PASSWORD"])
+			db := env["MYSQL_DATABASE"]
+			if port == "" {
+				port = "3306"
+			}
+			return fmt.Sprintf("%s:%s@tcp(localhost:%s)/%s", user, password, port, db), true
+		}
+	}
+	return "", false
+}
+
+func sortedServiceNames(services map[string]composeService) []string {
+	names := make([]string, 0, len(services))
+	for name := range services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// composeEnvironment normalizes docker-compose's "environment" key,
+// which may be written as either a YAML sequence of "KEY=VALUE"
+// strings or a YAML mapping of KEY: VALUE pairs.
+func composeEnvironment(node yaml.Node) map[string]string {
+	env := map[string]string{}
+	switch node.Kind {
+	case yaml.SequenceNode:
+		for _, item := range node.Content {
+			key, value, found := strings.Cut(item.Value, "=")
+			if found {
+				env[key] = value
+			}
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			env[node.Content[i].Value] = node.Content[i+1].Value
+		}
+	}
+	return env
+}
+
+// composeHostPort returns the host-side port of the first "host:container"
+// port mapping, or "" if ports has none in that shape.
+func composeHostPort(ports []string) string {
+	for _, p := range ports {
+		host, _, found := strings.Cut(p, ":")
+		if found {
+			return host
+		}
+	}
+	return ""
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,358 @@
+package cli
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/glebarez/sqlite"
+	"github.com/spf13/cobra"
+	"golang.org/x/tools/go/packages"
+
+	"gorm.io/gorm"
+
+	"github.com/go-gorm/cli/internal/pkgcache"
+)
+
+// fieldPackagePath is the import path whose presence in a file marks it
+// as using generated field helpers, which only type-check correctly
+// against the field package's generic wrappers. Files that never import
+// it - annotated query interfaces with raw SQL, for instance - don't
+// need full type information to be validated.
+const fieldPackagePath = `"github.com/go-gorm/cli/field"`
+
+// Check is one diagnostic `gorm doctor` ran, with a remediation hint to
+// print when it fails so the failure is actionable rather than a bare
+// red X.
+type Check struct {
+	Name        string
+	OK          bool
+	Detail      string
+	Remediation string
+}
+
+// runDoctor runs every environment diagnostic and returns their
+// results in a fixed, user-meaningful order. dsn may be empty, in which
+// case the DB connectivity check is skipped rather than failed.
+func runDoctor(ctx context.Context, dsn string) []Check {
+	checks := []Check{
+		checkGoToolchain(ctx),
+		checkModule(ctx),
+		checkPackageTypes(ctx),
+	}
+	if dsn != "" {
+		checks = append(checks, checkDBConnectivity(dsn))
+	}
+	return checks
+}
+
+func checkGoToolchain(ctx context.Context) Check {
+	path, err := exec.LookPath("go")
+	if err != nil {
+		return Check{
+			Name:        "go toolchain",
+			OK:          false,
+			Detail:      err.Error(),
+			Remediation: "install Go and ensure `go` is on PATH",
+		}
+	}
+
+	out, err := exec.CommandContext(ctx, path, "version").Output()
+	if err != nil {
+		return Check{
+			Name:        "go toolchain",
+			OK:          false,
+			Detail:      err.Error(),
+			Remediation: "run `go version` manually and fix the reported error",
+		}
+	}
+	return Check{Name: "go toolchain", OK: true, Detail: strings.TrimSpace(string(out))}
+}
+
+func checkModule(ctx context.Context) Check {
+	out, err := exec.CommandContext(ctx, "go", "env", "GOMOD").Output()
+	if err != nil {
+		return Check{
+			Name:        "module resolution",
+			OK:          false,
+			Detail:      err.Error(),
+			Remediation: "run `go env GOMOD` manually and fix the reported error",
+		}
+	}
+
+	gomod := strings.TrimSpace(string(out))
+	if gomod == "" || gomod == "/dev/null" {
+		return Check{
+			Name:        "module resolution",
+			OK:          false,
+			Detail:      "no go.mod found for the current directory",
+			Remediation: "run `gorm gen` from inside a module, or add a go.mod/go.work",
+		}
+	}
+	return Check{Name: "module resolution", OK: true, Detail: gomod}
+}
+
+// packageTypesCacheMode is the packages.Config.Mode checkPackageTypes
+// loads with; it's part of the pkgcache key so a cache entry from a
+// lower-fidelity load (e.g. one missing NeedTypesInfo) is never reused
+// for a higher-fidelity one.
+const packageTypesCacheMode = packages.NeedName | packages.NeedTypes | packages.NeedTypesInfo
+
+// checkPackageTypes loads the current module's packages with full type
+// information, catching the class of `go/packages` failure where a
+// package (commonly golang.org/x/text/cases) loads without types
+// because of a build constraint or module graph mismatch.
+//
+// The load is cached under pkgcache, keyed by a hash of the current
+// module's go.mod/go.sum together with a hash of its own .go source
+// files: loading gorm.io/gorm's schema package alone dominates this
+// check's runtime, so a second `gorm doctor` run against an unchanged
+// module reuses the prior outcome instead of re-walking and
+// re-typechecking the whole dependency graph. The source hash is what
+// lets a newly introduced type error in a local file invalidate the
+// cache immediately, rather than being masked until go.mod or go.sum
+// next changes.
+func checkPackageTypes(ctx context.Context) Check {
+	key, keyErr := pkgCacheKey()
+	if keyErr == nil {
+		var cached Check
+		if ok, err := pkgcache.Load(key, &cached); err == nil && ok {
+			return cached
+		}
+	}
+
+	check := loadPackageTypes(ctx)
+	if keyErr == nil {
+		_ = pkgcache.Store(key, check)
+	}
+	return check
+}
+
+func pkgCacheKey() (string, error) {
+	modKey, err := pkgcache.ModuleKey(".")
+	if err != nil {
+		return "", err
+	}
+	srcKey, err := sourceKey(".")
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("doctor-package-types-%s-%s-%d", modKey, srcKey, packageTypesCacheMode), nil
+}
+
+// sourceKey hashes the path and content of every non-test .go file
+// under dir, so pkgCacheKey changes whenever a source file loadPackageTypes
+// would type-check changes - unlike go.mod/go.sum, which stay fixed
+// across an ordinary edit-and-save loop.
+func sourceKey(dir string) (string, error) {
+	var paths []string
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+		paths = append(paths, path)
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	sort.Strings(paths)
+
+	h := sha256.New()
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return "", err
+		}
+		h.Write([]byte(path))
+		h.Write(data)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// loadPackageTypes loads the current module's packages with full type
+// information, unless every .go file in it skips the field package -
+// in which case there's nothing a type-checker would catch that a
+// syntax parse wouldn't, so it takes the much cheaper syntax-only path
+// instead.
+func loadPackageTypes(ctx context.Context) Check {
+	if needsTypes, err := anyFileUsesFieldPackage("."); err == nil && !needsTypes {
+		return checkSyntaxOnly(".")
+	}
+
+	cfg := &packages.Config{
+		Context: ctx,
+		Mode:    packageTypesCacheMode,
+	}
+	pkgs, err := packages.Load(cfg, "./...")
+	if err != nil {
+		return Check{
+			Name:        "package type-loading",
+			OK:          false,
+			Detail:      err.Error(),
+			Remediation: "run `go build ./...` and fix the reported error",
+		}
+	}
+
+	var broken []string
+	for _, p := range pkgs {
+		if p.Types != nil && !p.IllTyped {
+			continue
+		}
+		broken = append(broken, p.PkgPath)
+	}
+	if len(broken) > 0 {
+		return Check{
+			Name:        "package type-loading",
+			OK:          false,
+			Detail:      fmt.Sprintf("packages without complete type info: %s", strings.Join(broken, ", ")),
+			Remediation: "run `go mod tidy` and retry; a stale module cache often leaves a dependency's types unresolved",
+		}
+	}
+	return Check{Name: "package type-loading", OK: true, Detail: fmt.Sprintf("%d package(s) loaded with full types", len(pkgs))}
+}
+
+// anyFileUsesFieldPackage reports whether any non-test .go file under
+// dir imports the field package, scanning import declarations only
+// (via go/parser's ImportsOnly mode) rather than parsing full file
+// bodies.
+func anyFileUsesFieldPackage(dir string) (bool, error) {
+	found := false
+	fset := token.NewFileSet()
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+
+		file, err := parser.ParseFile(fset, path, nil, parser.ImportsOnly)
+		if err != nil {
+			return err
+		}
+		for _, imp := range file.Imports {
+			if imp.Path.Value == fieldPackagePath {
+				found = true
+				return fs.SkipAll
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return found, nil
+}
+
+// checkSyntaxOnly parses every non-test .go file under dir without
+// resolving types, catching plain syntax errors at a fraction of the
+// cost of a full go/packages load.
+func checkSyntaxOnly(dir string) Check {
+	fset := token.NewFileSet()
+	var parsed int
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+
+		if _, err := parser.ParseFile(fset, path, nil, parser.SkipObjectResolution); err != nil {
+			return err
+		}
+		parsed++
+		return nil
+	})
+	if err != nil {
+		return Check{
+			Name:        "package type-loading",
+			OK:          false,
+			Detail:      err.Error(),
+			Remediation: "fix the reported syntax error",
+		}
+	}
+	return Check{
+		Name:   "package type-loading",
+		OK:     true,
+		Detail: fmt.Sprintf("%d file(s) parsed syntax-only (no field-helper imports found)", parsed),
+	}
+}
+
+func checkDBConnectivity(dsn string) Check {
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{})
+	if err != nil {
+		return Check{
+			Name:        "database connectivity",
+			OK:          false,
+			Detail:      err.Error(),
+			Remediation: "check --dsn and that the database is reachable",
+		}
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return Check{
+			Name:        "database connectivity",
+			OK:          false,
+			Detail:      err.Error(),
+			Remediation: "check --dsn and that the database is reachable",
+		}
+	}
+	if err := sqlDB.Ping(); err != nil {
+		return Check{
+			Name:        "database connectivity",
+			OK:          false,
+			Detail:      err.Error(),
+			Remediation: "check --dsn and that the database is reachable",
+		}
+	}
+	return Check{Name: "database connectivity", OK: true, Detail: dsn}
+}
+
+func newDoctorCmd() *cobra.Command {
+	var dsn string
+
+	cmd := &cobra.Command{
+		Use:   "doctor",
+		Short: "Diagnose common environment problems",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			checks := runDoctor(cmd.Context(), dsn)
+			noColor, _ := cmd.Flags().GetBool("no-color")
+			paint := newPainter(cmd, noColor)
+
+			failed := 0
+			for _, c := range checks {
+				mark := paint.OK("ok  ")
+				if !c.OK {
+					mark = paint.Fail("FAIL")
+					failed++
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "[%s] %s: %s\n", mark, c.Name, c.Detail)
+				if !c.OK && c.Remediation != "" {
+					fmt.Fprintf(cmd.OutOrStdout(), "       -> %s\n", c.Remediation)
+				}
+			}
+			if failed > 0 {
+				return fmt.Errorf("doctor: %d check(s) failed", failed)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&dsn, "dsn", "", "data source name to check connectivity against (optional)")
+
+	return cmd
+}
@@ -0,0 +1,131 @@
+package cli
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRunDoctorGoToolchainAndModule(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	checks := runDoctor(context.Background(), "")
+
+	var sawToolchain, sawModule bool
+	for _, c := range checks {
+		switch c.Name {
+		case "go toolchain":
+			sawToolchain = true
+			if !c.OK {
+				t.Errorf("go toolchain check failed: %s", c.Detail)
+			}
+		case "module resolution":
+			sawModule = true
+		case "database connectivity":
+			t.Error("database connectivity check should be skipped when dsn is empty")
+		}
+	}
+	if !sawToolchain || !sawModule {
+		t.Errorf("checks = %+v, want go toolchain and module resolution checks", checks)
+	}
+}
+
+func TestRunDoctorDBConnectivity(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	checks := runDoctor(context.Background(), ":memory:")
+
+	var found bool
+	for _, c := range checks {
+		if c.Name == "database connectivity" {
+			found = true
+			if !c.OK {
+				t.Errorf("database connectivity check failed: %s", c.Detail)
+			}
+		}
+	}
+	if !found {
+		t.Error("want a database connectivity check when dsn is set")
+	}
+}
+
+func TestSourceKeyChangesWithFileContent(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "queries.go")
+	if err := os.WriteFile(file, []byte("package queries\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	k1, err := sourceKey(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(file, []byte("package queries\n\ntype User struct{}\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	k2, err := sourceKey(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if k1 == k2 {
+		t.Error("sourceKey did not change when a .go file's content changed")
+	}
+}
+
+func TestPkgCacheKeyChangesWhenSourceChanges(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module doctortest\n\ngo 1.22\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(dir, "queries.go")
+	if err := os.WriteFile(file, []byte("package queries\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	chdir(t, dir)
+
+	k1, err := pkgCacheKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(file, []byte("package queries\n\ntype broken struct\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	k2, err := pkgCacheKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if k1 == k2 {
+		t.Error("pkgCacheKey did not change when a local source file changed, so a stale doctor result would be served after introducing a type error")
+	}
+}
+
+func TestCheckPackageTypesSyntaxOnlyFastPath(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	chdir(t, t.TempDir())
+
+	src := `// @@table: users
+package queries
+
+type User interface {
+	// @@sql: select * from users where id = @id
+	GetByID(id int) (*User, error)
+}
+`
+	if err := os.WriteFile(filepath.Join(".", "queries.go"), []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	check := checkPackageTypes(context.Background())
+	if !check.OK {
+		t.Errorf("checkPackageTypes failed: %s", check.Detail)
+	}
+	if !strings.Contains(check.Detail, "syntax-only") {
+		t.Errorf("Detail = %q, want the syntax-only fast path to have been taken", check.Detail)
+	}
+}
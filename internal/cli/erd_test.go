@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestGenERDMermaidStdout(t *testing.T) {
+	dsn := newTestSQLiteFileWithSchema(t)
+
+	root := NewRootCmd()
+	var out bytes.Buffer
+	root.SetOut(&out)
+	root.SetArgs([]string{"gen", "erd", "--dsn", dsn, "users"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(out.Bytes(), []byte("erDiagram")) {
+		t.Errorf("output = %q, want a mermaid erDiagram", out.String())
+	}
+}
+
+func TestGenERDDot(t *testing.T) {
+	dsn := newTestSQLiteFileWithSchema(t)
+
+	root := NewRootCmd()
+	var out bytes.Buffer
+	root.SetOut(&out)
+	root.SetArgs([]string{"gen", "erd", "--dsn", dsn, "--format", "dot", "users"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(out.Bytes(), []byte("digraph erd {")) {
+		t.Errorf("output = %q, want a digraph", out.String())
+	}
+}
+
+func TestGenERDUnknownFormat(t *testing.T) {
+	dsn := newTestSQLiteFileWithSchema(t)
+
+	root := NewRootCmd()
+	root.SetArgs([]string{"gen", "erd", "--dsn", dsn, "--format", "svg", "users"})
+	if err := root.Execute(); err == nil {
+		t.Fatal("want an error for an unknown --format")
+	}
+}
+
+func TestGenERDRequiresDSN(t *testing.T) {
+	root := NewRootCmd()
+	root.SetArgs([]string{"gen", "erd"})
+	if err := root.Execute(); err == nil {
+		t.Fatal("want an error when --dsn is not set")
+	}
+}
@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"context"
+	"errors"
+)
+
+// Exit codes returned by the gorm CLI. Scripts can branch on these
+// instead of parsing stderr. 0 is the only code that means full
+// success; every other value is stable and assigned here, not ad hoc
+// per command.
+const (
+	ExitOK              = 0
+	ExitUsageError      = 2
+	ExitGenerationError = 3
+	ExitMigrationError  = 4
+	ExitDriftDetected   = 5
+	ExitPartialSuccess  = 6
+	// ExitTimeout is returned when --timeout elapses before a command
+	// finishes its current unit of work.
+	ExitTimeout = 7
+	// ExitInterrupted is returned when SIGINT/SIGTERM arrives before a
+	// command finishes its current unit of work.
+	ExitInterrupted = 8
+)
+
+// errFromContext maps ctx's error to a CodedError with the exit code
+// that distinguishes why a command stopped early: ExitTimeout for
+// --timeout elapsing, ExitInterrupted for SIGINT/SIGTERM. It returns
+// nil if ctx hasn't been canceled.
+func errFromContext(ctx context.Context) error {
+	switch ctx.Err() {
+	case context.DeadlineExceeded:
+		return errWithCode(ExitTimeout, ctx.Err())
+	case context.Canceled:
+		return errWithCode(ExitInterrupted, errors.New("interrupted"))
+	default:
+		return nil
+	}
+}
+
+// CodedError pairs an error with the exit code main should report for
+// it. Commands return one, via errWithCode, when the default usage-error
+// code is wrong for their failure.
+type CodedError struct {
+	Code int
+	Err  error
+}
+
+func (e *CodedError) Error() string { return e.Err.Error() }
+func (e *CodedError) Unwrap() error { return e.Err }
+
+// errWithCode wraps err so ExitCode reports code for it. Returns nil
+// if err is nil, so callers can use it unconditionally around a
+// fallible step's result.
+func errWithCode(code int, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &CodedError{Code: code, Err: err}
+}
+
+// ExitCode maps an error returned by Execute to a process exit code.
+// nil maps to ExitOK. Errors not wrapped via errWithCode - including
+// cobra's own errors for unknown flags/commands - map to
+// ExitUsageError, since that's what they are.
+func ExitCode(err error) int {
+	if err == nil {
+		return ExitOK
+	}
+	var coded *CodedError
+	if errors.As(err, &coded) {
+		return coded.Code
+	}
+	return ExitUsageError
+}
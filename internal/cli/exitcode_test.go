@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestExitCodeMapping(t *testing.T) {
+	if got := ExitCode(nil); got != ExitOK {
+		t.Errorf("ExitCode(nil) = %d, want %d", got, ExitOK)
+	}
+	if got := ExitCode(errors.New("plain error")); got != ExitUsageError {
+		t.Errorf("ExitCode(plain) = %d, want %d", got, ExitUsageError)
+	}
+	if got := ExitCode(errWithCode(ExitGenerationError, errors.New("boom"))); got != ExitGenerationError {
+		t.Errorf("ExitCode(coded) = %d, want %d", got, ExitGenerationError)
+	}
+}
+
+func TestExitCodeUnwrapsThroughFmtErrorf(t *testing.T) {
+	coded := errWithCode(ExitPartialSuccess, errors.New("boom"))
+	if got := ExitCode(fmt.Errorf("context: %w", coded)); got != ExitPartialSuccess {
+		t.Errorf("ExitCode(%%w-wrapped) = %d, want %d", got, ExitPartialSuccess)
+	}
+
+	wrapped := errors.New("context: " + coded.Error())
+	if got := ExitCode(wrapped); got != ExitUsageError {
+		t.Errorf("ExitCode(plain-wrapped) = %d, want %d (no CodedError in chain)", got, ExitUsageError)
+	}
+}
+
+func TestGenUsageErrorExitCode(t *testing.T) {
+	root := NewRootCmd()
+	root.SetArgs([]string{"gen"})
+	err := root.Execute()
+	if err == nil {
+		t.Fatal("want error when --dsn is not set")
+	}
+	if got := ExitCode(err); got != ExitUsageError {
+		t.Errorf("ExitCode = %d, want %d", got, ExitUsageError)
+	}
+}
@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/go-gorm/cli/internal/generator"
+)
+
+// newGenFactoriesCmd builds `gorm gen factories`, which emits a
+// NewX(overrides ...func(*X)) *X test factory per model plus a CreateX
+// helper that persists it through the generics API, so test setup code
+// doesn't hand-roll fixtures that drift out of sync with the schema.
+func newGenFactoriesCmd() *cobra.Command {
+	var dsn, out string
+
+	cmd := &cobra.Command{
+		Use:   "factories [tables...]",
+		Short: "Generate test factories for one or more tables",
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if dsn == "" {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			tables, err := listTables(dsn)
+			if err != nil {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			return tables, cobra.ShellCompDirectiveNoFileComp
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if dsn == "" {
+				return errWithCode(ExitUsageError, fmt.Errorf("factories: --dsn is required"))
+			}
+
+			names := args
+			if len(names) == 0 {
+				var err error
+				names, err = listTables(dsn)
+				if err != nil {
+					return errWithCode(ExitGenerationError, err)
+				}
+			}
+
+			tables := make([]generator.Table, len(names))
+			for i, name := range names {
+				t, err := loadTable(dsn, name)
+				if err != nil {
+					return errWithCode(ExitGenerationError, err)
+				}
+				tables[i] = t
+			}
+
+			code := generator.RenderFactories(tables)
+
+			if out == "" {
+				fmt.Fprint(cmd.OutOrStdout(), code)
+				return nil
+			}
+			if err := os.WriteFile(out, []byte(code), 0o644); err != nil {
+				return errWithCode(ExitGenerationError, err)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&dsn, "dsn", "", "data source name of the database to introspect")
+	cmd.Flags().StringVar(&out, "out", "", "file to write the generated factory code to (default: stdout)")
+	cmd.MarkFlagFilename("out", "go")
+
+	return cmd
+}
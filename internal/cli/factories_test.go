@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"os"
+)
+
+func TestGenFactoriesStdout(t *testing.T) {
+	dsn := newTestSQLiteFileWithSchema(t)
+
+	root := NewRootCmd()
+	var out bytes.Buffer
+	root.SetOut(&out)
+	root.SetArgs([]string{"gen", "factories", "--dsn", dsn, "users"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(out.Bytes(), []byte("func NewUsers(overrides ...func(*Users)) *Users {")) {
+		t.Errorf("output = %q, want a NewUsers factory", out.String())
+	}
+	if !bytes.Contains(out.Bytes(), []byte("func CreateUsers(ctx context.Context, q gen.Interface[Users]")) {
+		t.Errorf("output = %q, want a CreateUsers helper", out.String())
+	}
+}
+
+func TestGenFactoriesWritesFile(t *testing.T) {
+	dsn := newTestSQLiteFileWithSchema(t)
+	out := filepath.Join(t.TempDir(), "factories_gen.go")
+
+	root := NewRootCmd()
+	root.SetOut(&bytes.Buffer{})
+	root.SetArgs([]string{"gen", "factories", "--dsn", dsn, "--out", out, "users"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(data, []byte("func NewUsers(")) {
+		t.Errorf("file contents = %q, want a NewUsers factory", data)
+	}
+}
+
+func TestGenFactoriesRequiresDSN(t *testing.T) {
+	root := NewRootCmd()
+	root.SetArgs([]string{"gen", "factories"})
+	if err := root.Execute(); err == nil {
+		t.Fatal("want an error when --dsn is not set")
+	}
+}
@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/go-gorm/cli/internal/generator"
+)
+
+// newGenFromEntCmd builds `gorm gen from-ent`, which converts the
+// Fields() methods in an ent schema directory into equivalent gorm
+// model structs and field helpers, easing migration off ent.
+func newGenFromEntCmd() *cobra.Command {
+	var out string
+
+	cmd := &cobra.Command{
+		Use:   "from-ent <schema-dir>",
+		Short: "Convert ent schemas into gorm models and field helpers",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			tables, err := parseEntSchemaDir(args[0])
+			if err != nil {
+				return errWithCode(ExitGenerationError, err)
+			}
+			if collisions := generator.CheckIdentifiers(generator.Config{}, tables); len(collisions) > 0 {
+				return errWithCode(ExitGenerationError, generator.CollisionError(collisions))
+			}
+
+			code := generator.RenderModels(tables)
+
+			if out == "" {
+				fmt.Fprint(cmd.OutOrStdout(), code)
+				return nil
+			}
+			if err := os.WriteFile(out, []byte(code), 0o644); err != nil {
+				return errWithCode(ExitGenerationError, err)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&out, "out", "", "file to write the generated model code to (default: stdout)")
+	cmd.MarkFlagFilename("out", "go")
+
+	return cmd
+}
+
+// parseEntSchemaDir parses every *.go file in dir for ent Fields()
+// methods, skipping files that don't define any.
+func parseEntSchemaDir(dir string) ([]generator.Table, error) {
+	var tables []generator.Table
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.HasSuffix(path, ".go") {
+			return nil
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		parsed, err := generator.ParseEntSchemas(string(data))
+		if err != nil {
+			return nil // file defines no ent schema; not every .go file in the tree has to
+		}
+		tables = append(tables, parsed...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(tables) == 0 {
+		return nil, fmt.Errorf("from-ent: no ent schemas found in %s", dir)
+	}
+	return tables, nil
+}
@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGenFromEnt(t *testing.T) {
+	dir := t.TempDir()
+	schema := `package schema
+
+type User struct {
+	ent.Schema
+}
+
+func (User) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name"),
+		field.Int("age").Optional(),
+	}
+}
+`
+	if err := os.WriteFile(filepath.Join(dir, "user.go"), []byte(schema), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	root := NewRootCmd()
+	var out bytes.Buffer
+	root.SetOut(&out)
+	root.SetArgs([]string{"gen", "from-ent", dir})
+
+	if err := root.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(out.Bytes(), []byte("type Users struct {")) {
+		t.Errorf("output = %q, want a Users struct", out.String())
+	}
+}
+
+func TestGenFromEntRequiresSchemas(t *testing.T) {
+	root := NewRootCmd()
+	root.SetArgs([]string{"gen", "from-ent", t.TempDir()})
+	if err := root.Execute(); err == nil {
+		t.Fatal("want an error when the directory has no ent schemas")
+	}
+}
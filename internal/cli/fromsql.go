@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/go-gorm/cli/internal/generator"
+)
+
+// newGenFromSQLCmd builds `gorm gen from-sql`, which parses the CREATE
+// TABLE statements in a checked-in DDL file and emits model structs
+// plus field helpers without connecting to a database, for teams whose
+// schema source of truth is DDL that CI can't point the CLI's --dsn at.
+func newGenFromSQLCmd() *cobra.Command {
+	var out string
+
+	cmd := &cobra.Command{
+		Use:   "from-sql <schema.sql>",
+		Short: "Generate model structs and field helpers from a DDL file",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			data, err := os.ReadFile(args[0])
+			if err != nil {
+				return errWithCode(ExitUsageError, err)
+			}
+
+			tables, err := generator.ParseDDL(string(data))
+			if err != nil {
+				return errWithCode(ExitGenerationError, err)
+			}
+			if collisions := generator.CheckIdentifiers(generator.Config{}, tables); len(collisions) > 0 {
+				return errWithCode(ExitGenerationError, generator.CollisionError(collisions))
+			}
+
+			code := generator.RenderModels(tables)
+
+			if out == "" {
+				fmt.Fprint(cmd.OutOrStdout(), code)
+				return nil
+			}
+			if err := os.WriteFile(out, []byte(code), 0o644); err != nil {
+				return errWithCode(ExitGenerationError, err)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&out, "out", "", "file to write the generated model code to (default: stdout)")
+	cmd.MarkFlagFilename("out", "go")
+
+	return cmd
+}
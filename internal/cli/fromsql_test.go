@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"os"
+)
+
+func TestGenFromSQL(t *testing.T) {
+	dir := t.TempDir()
+	schema := filepath.Join(dir, "schema.sql")
+	sql := `
+CREATE TABLE users (
+	id INTEGER NOT NULL PRIMARY KEY,
+	name TEXT NOT NULL
+);
+
+CREATE TABLE posts (
+	id INTEGER NOT NULL PRIMARY KEY,
+	author_id INTEGER NOT NULL REFERENCES users(id)
+);
+`
+	if err := os.WriteFile(schema, []byte(sql), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	root := NewRootCmd()
+	var out bytes.Buffer
+	root.SetOut(&out)
+	root.SetArgs([]string{"gen", "from-sql", schema})
+
+	if err := root.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(out.Bytes(), []byte("type Users struct {")) {
+		t.Errorf("output = %q, want a Users struct", out.String())
+	}
+	if !bytes.Contains(out.Bytes(), []byte("type Posts struct {")) {
+		t.Errorf("output = %q, want a Posts struct", out.String())
+	}
+}
+
+func TestGenFromSQLRequiresExistingFile(t *testing.T) {
+	root := NewRootCmd()
+	root.SetArgs([]string{"gen", "from-sql", filepath.Join(t.TempDir(), "missing.sql")})
+	if err := root.Execute(); err == nil {
+		t.Fatal("want an error when the schema file doesn't exist")
+	}
+}
+
+func TestGenFromSQLRejectsKeywordTableName(t *testing.T) {
+	dir := t.TempDir()
+	schema := filepath.Join(dir, "schema.sql")
+	sql := `CREATE TABLE range (id INTEGER NOT NULL PRIMARY KEY);`
+	if err := os.WriteFile(schema, []byte(sql), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	root := NewRootCmd()
+	root.SetArgs([]string{"gen", "from-sql", schema})
+	if err := root.Execute(); err == nil {
+		t.Fatal("want an error when a table name would generate a non-compiling accessor variable")
+	}
+}
@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/go-gorm/cli/internal/generator"
+)
+
+// newGenFromSQLCCmd builds `gorm gen from-sqlc`, which converts a sqlc
+// query file's `-- name: Foo :one` annotated queries into an equivalent
+// gorm gen annotated query interface, easing migration off sqlc.
+func newGenFromSQLCCmd() *cobra.Command {
+	var out string
+
+	cmd := &cobra.Command{
+		Use:   "from-sqlc <queries.sql>",
+		Short: "Convert sqlc annotated queries into a gorm gen query interface",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			data, err := os.ReadFile(args[0])
+			if err != nil {
+				return errWithCode(ExitUsageError, err)
+			}
+
+			queries, err := generator.ParseSQLCQueries(string(data))
+			if err != nil {
+				return errWithCode(ExitGenerationError, err)
+			}
+
+			code := generator.RenderAnnotatedInterfaces(queries)
+
+			if out == "" {
+				fmt.Fprint(cmd.OutOrStdout(), code)
+				return nil
+			}
+			if err := os.WriteFile(out, []byte(code), 0o644); err != nil {
+				return errWithCode(ExitGenerationError, err)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&out, "out", "", "file to write the generated query interface to (default: stdout)")
+	cmd.MarkFlagFilename("out", "go")
+
+	return cmd
+}
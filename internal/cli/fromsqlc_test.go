@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGenFromSQLC(t *testing.T) {
+	dir := t.TempDir()
+	queries := filepath.Join(dir, "queries.sql")
+	sql := "-- name: GetUser :one\nSELECT * FROM users WHERE id = $1;\n"
+	if err := os.WriteFile(queries, []byte(sql), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	root := NewRootCmd()
+	var out bytes.Buffer
+	root.SetOut(&out)
+	root.SetArgs([]string{"gen", "from-sqlc", queries})
+
+	if err := root.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(out.Bytes(), []byte("type Users interface {")) {
+		t.Errorf("output = %q, want a Users interface", out.String())
+	}
+	if !bytes.Contains(out.Bytes(), []byte("GetUser(args ...any)")) {
+		t.Errorf("output = %q, want a GetUser method", out.String())
+	}
+}
+
+func TestGenFromSQLCRequiresExistingFile(t *testing.T) {
+	root := NewRootCmd()
+	root.SetArgs([]string{"gen", "from-sqlc", filepath.Join(t.TempDir(), "missing.sql")})
+	if err := root.Execute(); err == nil {
+		t.Fatal("want an error when the query file doesn't exist")
+	}
+}
@@ -0,0 +1,219 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/go-gorm/cli/internal/generator"
+)
+
+// newGenCmd builds the `gorm gen` command, which generates typed query
+// code for the tables named as arguments, or every table in the
+// database if none are named.
+//
+// Table-name arguments and the --dsn/--output flags register dynamic
+// shell completion: table names are read live from the target database,
+// and --output completes to paths, so `gorm completion` stays useful as
+// the flag surface grows instead of falling back to plain file globbing.
+//
+// --diff swaps the write path for a preview: it renders what would be
+// generated and diffs it against whatever is already on disk, without
+// touching any files.
+func newGenCmd() *cobra.Command {
+	var dsn, input, output, format string
+	var quiet, diff, deterministic bool
+
+	cmd := &cobra.Command{
+		Use:   "gen [tables...]",
+		Short: "Generate typed query code for one or more tables",
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if dsn == "" {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			tables, err := listTables(dsn)
+			if err != nil {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			return tables, cobra.ShellCompDirectiveNoFileComp
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if dsn == "" && input == "" {
+				return errWithCode(ExitUsageError, fmt.Errorf("gen: one of --dsn or --input is required"))
+			}
+			switch format {
+			case "text", "json":
+			default:
+				return errWithCode(ExitUsageError, fmt.Errorf("gen: unknown --format %q, want \"text\" or \"json\"", format))
+			}
+
+			tables := args
+			if len(tables) == 0 && input != "" {
+				var err error
+				tables, err = generator.AnnotatedInterfaces(input)
+				if err != nil {
+					return errWithCode(ExitGenerationError, err)
+				}
+			}
+			if len(tables) == 0 && dsn != "" {
+				var err error
+				tables, err = listTables(dsn)
+				if err != nil {
+					return errWithCode(ExitGenerationError, err)
+				}
+			}
+
+			if err := generator.CheckGormCompatibility(output); err != nil {
+				return errWithCode(ExitGenerationError, err)
+			}
+
+			if deterministic {
+				return runGenDeterministicCheck(cmd, tables)
+			}
+
+			if diff {
+				return runGenDiff(cmd, format, tables, output)
+			}
+
+			progressOut := cmd.ErrOrStderr()
+			if quiet {
+				progressOut = io.Discard
+			}
+			progress := newProgressReporter(progressOut, len(tables))
+
+			result := generator.Result{Inputs: tables}
+			for i, table := range tables {
+				if err := errFromContext(cmd.Context()); err != nil {
+					return err
+				}
+				path, err := generator.WriteTable(table, output)
+				if err != nil {
+					return errWithCode(ExitGenerationError, err)
+				}
+				result.Outputs = append(result.Outputs, path)
+				progress.step(i+1, table)
+			}
+
+			noColor, _ := cmd.Flags().GetBool("no-color")
+			if err := writeGenResult(cmd, format, result, newPainter(cmd, noColor)); err != nil {
+				return errWithCode(ExitGenerationError, err)
+			}
+			if len(result.Skipped) > 0 {
+				return errWithCode(ExitPartialSuccess, fmt.Errorf("gen: %d table(s) skipped", len(result.Skipped)))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&dsn, "dsn", "", "data source name of the database to generate from")
+	cmd.Flags().StringVarP(&input, "input", "i", "", "generate from an annotated Go source file's interfaces instead of --dsn, for a //go:generate gorm gen -i $GOFILE directive")
+	cmd.Flags().StringVarP(&output, "output", "o", ".", "directory to write generated code to")
+	cmd.Flags().StringVar(&format, "format", "text", `output format: "text" or "json"`)
+	cmd.Flags().BoolVar(&quiet, "quiet", false, "suppress per-table progress reporting")
+	cmd.Flags().BoolVar(&diff, "diff", false, "show a unified diff against the committed generated files instead of writing")
+	cmd.Flags().BoolVar(&deterministic, "deterministic", false, "render each table twice and fail if the two renders aren't byte-identical, instead of writing")
+	cmd.MarkFlagDirname("output")
+	cmd.MarkFlagFilename("input", "go")
+	cmd.AddCommand(newGenConfigCmd())
+	cmd.AddCommand(newGenOpenAPICmd())
+	cmd.AddCommand(newGenProtoCmd())
+	cmd.AddCommand(newGenGraphQLCmd())
+	cmd.AddCommand(newGenFromSQLCmd())
+	cmd.AddCommand(newGenFromSQLCCmd())
+	cmd.AddCommand(newGenFromEntCmd())
+	cmd.AddCommand(newGenERDCmd())
+	cmd.AddCommand(newGenTypeScriptCmd())
+	cmd.AddCommand(newGenFactoriesCmd())
+	cmd.AddCommand(newGenBenchCmd())
+	cmd.AddCommand(newGenTestSQLCmd())
+
+	return cmd
+}
+
+// runGenDiff previews what `gorm gen` would write for tables as a unified
+// diff against whatever is currently on disk at output, without writing
+// anything, so a reviewer can assess the blast radius of a model or
+// config change before it's applied.
+func runGenDiff(cmd *cobra.Command, format string, tables []string, output string) error {
+	diffs := make([]TableDiff, len(tables))
+	changed := 0
+	for i, table := range tables {
+		path := filepath.Join(output, table+"_gen.go")
+		d, err := diffTable(table, path, generator.Render(table))
+		if err != nil {
+			return errWithCode(ExitGenerationError, err)
+		}
+		diffs[i] = d
+		if d.Changed {
+			changed++
+		}
+	}
+
+	out := cmd.OutOrStdout()
+	if format == "json" {
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(diffs); err != nil {
+			return errWithCode(ExitGenerationError, err)
+		}
+	} else {
+		for _, d := range diffs {
+			if d.Changed {
+				fmt.Fprint(out, d.Diff)
+			}
+		}
+	}
+
+	if changed > 0 {
+		return errWithCode(ExitDriftDetected, fmt.Errorf("gen: %d table(s) differ from the committed generated files", changed))
+	}
+	return nil
+}
+
+// runGenDeterministicCheck renders each table twice and compares the
+// two renders byte-for-byte, without writing anything. It exists so a
+// build cache or checksum keyed on generated output can trust that
+// output unconditionally - if a future parallelized or cached render
+// path ever introduces scheduling-dependent output (e.g. from unstable
+// import ordering), this is what catches it.
+func runGenDeterministicCheck(cmd *cobra.Command, tables []string) error {
+	var mismatched []string
+	for _, table := range tables {
+		first := generator.Render(table)
+		second := generator.Render(table)
+		if first != second {
+			mismatched = append(mismatched, table)
+		}
+	}
+	if len(mismatched) > 0 {
+		return errWithCode(ExitGenerationError, fmt.Errorf("gen: non-deterministic output for table(s): %s", strings.Join(mismatched, ", ")))
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "%d table(s) rendered deterministically\n", len(tables))
+	return nil
+}
+
+// writeGenResult renders result to cmd's output stream in the requested format.
+func writeGenResult(cmd *cobra.Command, format string, result generator.Result, paint *painter) error {
+	out := cmd.OutOrStdout()
+	if format == "json" {
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(result)
+	}
+
+	fmt.Fprintf(out, "processed %d table(s):\n", len(result.Inputs))
+	for i, table := range result.Inputs {
+		fmt.Fprintf(out, "  %s -> %s\n", table, result.Outputs[i])
+	}
+	for _, w := range result.Warnings {
+		fmt.Fprintf(out, "%s: %s\n", paint.Warn("warning"), w)
+	}
+	for _, s := range result.Skipped {
+		fmt.Fprintf(out, "%s %s: %s\n", paint.Warn("skipped"), s.Table, s.Reason)
+	}
+	return nil
+}
@@ -0,0 +1,213 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/go-gorm/cli/internal/generator"
+)
+
+// newTestOutputDir returns a temp directory with a go.mod requiring a
+// current gorm.io/gorm, so CheckGormCompatibility accepts it as a --output
+// target the way a real generated-code destination module would be.
+func newTestOutputDir(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	mod := "module gentest\n\ngo 1.22\n\nrequire gorm.io/gorm v1.25.12\n"
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte(mod), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func newTestSQLiteFile(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "test.db")
+	db, err := gorm.Open(sqlite.Open(path), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Exec("CREATE TABLE users (id INTEGER PRIMARY KEY)").Error; err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestGenJSONFormat(t *testing.T) {
+	dsn := newTestSQLiteFile(t)
+	output := newTestOutputDir(t)
+
+	root := NewRootCmd()
+	var out bytes.Buffer
+	root.SetOut(&out)
+	root.SetArgs([]string{"gen", "--dsn", dsn, "--output", output, "--format", "json", "users"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	var result generator.Result
+	if err := json.Unmarshal(out.Bytes(), &result); err != nil {
+		t.Fatalf("unmarshal %q: %v", out.String(), err)
+	}
+	if len(result.Inputs) != 1 || result.Inputs[0] != "users" {
+		t.Errorf("Inputs = %v, want [users]", result.Inputs)
+	}
+	if len(result.Outputs) != 1 {
+		t.Errorf("Outputs = %v, want one entry", result.Outputs)
+	}
+}
+
+func TestGenWritesGeneratedFile(t *testing.T) {
+	dsn := newTestSQLiteFile(t)
+	output := newTestOutputDir(t)
+
+	root := NewRootCmd()
+	root.SetOut(&bytes.Buffer{})
+	root.SetArgs([]string{"gen", "--dsn", dsn, "--output", output, "users"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(output, "users_gen.go")
+	written, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read %s: %v", path, err)
+	}
+	if want := generator.Render("users"); string(written) != want {
+		t.Errorf("written content = %q, want %q", written, want)
+	}
+}
+
+func TestGenTextFormat(t *testing.T) {
+	dsn := newTestSQLiteFile(t)
+	output := newTestOutputDir(t)
+
+	root := NewRootCmd()
+	var out bytes.Buffer
+	root.SetOut(&out)
+	root.SetArgs([]string{"gen", "--dsn", dsn, "--output", output, "users"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(out.Bytes(), []byte("users")) {
+		t.Errorf("output = %q, want it to mention users", out.String())
+	}
+}
+
+func TestGenProgressReporting(t *testing.T) {
+	dsn := newTestSQLiteFile(t)
+	output := newTestOutputDir(t)
+
+	root := NewRootCmd()
+	var out, errOut bytes.Buffer
+	root.SetOut(&out)
+	root.SetErr(&errOut)
+	root.SetArgs([]string{"gen", "--dsn", dsn, "--output", output, "users"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(errOut.Bytes(), []byte("[1/1] users")) {
+		t.Errorf("stderr = %q, want progress for users", errOut.String())
+	}
+}
+
+func TestGenQuietSuppressesProgress(t *testing.T) {
+	dsn := newTestSQLiteFile(t)
+	output := newTestOutputDir(t)
+
+	root := NewRootCmd()
+	var out, errOut bytes.Buffer
+	root.SetOut(&out)
+	root.SetErr(&errOut)
+	root.SetArgs([]string{"gen", "--dsn", dsn, "--output", output, "--quiet", "users"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if errOut.Len() != 0 {
+		t.Errorf("stderr = %q, want no progress output with --quiet", errOut.String())
+	}
+}
+
+func TestGenDeterministic(t *testing.T) {
+	dsn := newTestSQLiteFile(t)
+
+	root := NewRootCmd()
+	var out bytes.Buffer
+	root.SetOut(&out)
+	root.SetArgs([]string{"gen", "--dsn", dsn, "--deterministic", "users"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(out.Bytes(), []byte("rendered deterministically")) {
+		t.Errorf("output = %q, want a deterministic-rendering confirmation", out.String())
+	}
+}
+
+func TestGenInputModeUsesAnnotatedInterfaces(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	dir := newTestOutputDir(t)
+	queries := filepath.Join(dir, "queries.go")
+	if err := os.WriteFile(queries, []byte(`package query
+
+type Users interface {
+	GetByID(id int) (User, error)
+}
+
+type User struct{}
+`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	root := NewRootCmd()
+	var out bytes.Buffer
+	root.SetOut(&out)
+	root.SetArgs([]string{"gen", "--input", queries, "--output", dir, "--format", "json"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	var result generator.Result
+	if err := json.Unmarshal(out.Bytes(), &result); err != nil {
+		t.Fatalf("unmarshal %q: %v", out.String(), err)
+	}
+	if len(result.Inputs) != 1 || result.Inputs[0] != "Users" {
+		t.Errorf("Inputs = %v, want [Users]", result.Inputs)
+	}
+}
+
+func TestGenRequiresDSNOrInput(t *testing.T) {
+	root := NewRootCmd()
+	root.SetArgs([]string{"gen"})
+	if err := root.Execute(); err == nil {
+		t.Fatal("want error when neither --dsn nor --input is set")
+	}
+}
+
+func TestGenRespectsTimeout(t *testing.T) {
+	dsn := newTestSQLiteFile(t)
+
+	root := NewRootCmd()
+	root.SetArgs([]string{"gen", "--dsn", dsn, "--output", newTestOutputDir(t), "--timeout", "1ns", "users"})
+
+	err := root.Execute()
+	if err == nil {
+		t.Fatal("want an error when --timeout elapses before gen finishes")
+	}
+	if code := ExitCode(err); code != ExitTimeout {
+		t.Errorf("ExitCode(err) = %d, want ExitTimeout (%d)", code, ExitTimeout)
+	}
+}
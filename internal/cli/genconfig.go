@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/go-gorm/cli/internal/generator"
+)
+
+// newGenConfigCmd builds the `gorm gen config` command group, for
+// inspecting genconfig.yaml settings without running a real generation.
+func newGenConfigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Inspect genconfig.yaml settings",
+	}
+	cmd.AddCommand(newGenConfigValidateCmd())
+	return cmd
+}
+
+// newGenConfigValidateCmd builds `gorm gen config validate`, which
+// loads every genconfig.yaml in dir, flags conflicting OutPaths and
+// references to tables that don't exist, and prints the effective
+// merged configuration — catching misconfigurations before a long
+// generation run.
+func newGenConfigValidateCmd() *cobra.Command {
+	var dsn string
+
+	cmd := &cobra.Command{
+		Use:   "validate [dir]",
+		Short: "Validate every genconfig.yaml in dir and print the effective merged configuration",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dir := "."
+			if len(args) > 0 {
+				dir = args[0]
+			}
+
+			configs, err := generator.FindConfigs(dir)
+			if err != nil {
+				return errWithCode(ExitGenerationError, err)
+			}
+
+			var tables []string
+			if dsn != "" {
+				tables, err = listTables(dsn)
+				if err != nil {
+					return errWithCode(ExitGenerationError, err)
+				}
+			}
+
+			noColor, _ := cmd.Flags().GetBool("no-color")
+			paint := newPainter(cmd, noColor)
+			problems := generator.ValidateConfigs(configs, tables)
+			for _, p := range problems {
+				fmt.Fprintf(cmd.ErrOrStderr(), "%s %s\n", paint.Fail("FAIL"), p)
+			}
+
+			enc := yaml.NewEncoder(cmd.OutOrStdout())
+			defer enc.Close()
+			if err := enc.Encode(generator.MergeConfigs(configs)); err != nil {
+				return errWithCode(ExitGenerationError, err)
+			}
+
+			if len(problems) > 0 {
+				return errWithCode(ExitUsageError, fmt.Errorf("config validate: %d problem(s) found across %d genconfig.yaml file(s)", len(problems), len(configs)))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&dsn, "dsn", "", "data source name to validate table references against (optional)")
+	return cmd
+}
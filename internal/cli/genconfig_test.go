@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGenConfigValidateCleanTree(t *testing.T) {
+	dir := t.TempDir()
+	writeTestConfigFile(t, filepath.Join(dir, "genconfig.yaml"), "forbidUntypedOrder: true\n")
+
+	root := NewRootCmd()
+	var out bytes.Buffer
+	root.SetOut(&out)
+	root.SetArgs([]string{"gen", "config", "validate", dir})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("want no error for a consistent tree, got %v", err)
+	}
+	if !bytes.Contains(out.Bytes(), []byte("forbidUntypedOrder: true")) {
+		t.Errorf("output = %q, want the merged config printed", out.String())
+	}
+}
+
+func TestGenConfigValidateDetectsOutPathCollision(t *testing.T) {
+	dir := t.TempDir()
+	writeTestConfigFile(t, filepath.Join(dir, "a", "genconfig.yaml"), "outPath: ../shared\n")
+	writeTestConfigFile(t, filepath.Join(dir, "b", "genconfig.yaml"), "outPath: ../shared\n")
+
+	root := NewRootCmd()
+	var out, errOut bytes.Buffer
+	root.SetOut(&out)
+	root.SetErr(&errOut)
+	root.SetArgs([]string{"gen", "config", "validate", dir})
+
+	err := root.Execute()
+	if err == nil {
+		t.Fatal("want an error for colliding outPaths")
+	}
+	if got := ExitCode(err); got != ExitUsageError {
+		t.Errorf("ExitCode = %d, want %d", got, ExitUsageError)
+	}
+	if !bytes.Contains(errOut.Bytes(), []byte("outPath")) {
+		t.Errorf("stderr = %q, want it to mention the outPath collision", errOut.String())
+	}
+}
+
+func TestGenConfigValidateUnknownTable(t *testing.T) {
+	dsn := newTestSQLiteFile(t)
+	dir := t.TempDir()
+	writeTestConfigFile(t, filepath.Join(dir, "genconfig.yaml"), "fieldNameMap:\n  ghosts:\n    id: ID\n")
+
+	root := NewRootCmd()
+	root.SetOut(&bytes.Buffer{})
+	root.SetArgs([]string{"gen", "config", "validate", "--dsn", dsn, dir})
+
+	err := root.Execute()
+	if err == nil {
+		t.Fatal("want an error for a fieldNameMap table that doesn't exist in the database")
+	}
+}
+
+func writeTestConfigFile(t *testing.T, path, contents string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/go-gorm/cli/internal/generator"
+)
+
+// newGenGraphQLCmd builds `gorm gen graphql`, which exports model
+// structs as GraphQL SDL types, with foreign keys rendered as nested
+// relation fields, to bootstrap a GraphQL API over an existing schema.
+func newGenGraphQLCmd() *cobra.Command {
+	var dsn, out string
+
+	cmd := &cobra.Command{
+		Use:   "graphql [tables...]",
+		Short: "Export model structs as a GraphQL SDL schema",
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if dsn == "" {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			tables, err := listTables(dsn)
+			if err != nil {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			return tables, cobra.ShellCompDirectiveNoFileComp
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if dsn == "" {
+				return errWithCode(ExitUsageError, fmt.Errorf("graphql: --dsn is required"))
+			}
+
+			names := args
+			if len(names) == 0 {
+				var err error
+				names, err = listTables(dsn)
+				if err != nil {
+					return errWithCode(ExitGenerationError, err)
+				}
+			}
+
+			tables := make([]generator.Table, len(names))
+			for i, name := range names {
+				t, err := loadTable(dsn, name)
+				if err != nil {
+					return errWithCode(ExitGenerationError, err)
+				}
+				tables[i] = t
+			}
+
+			schema := generator.BuildGraphQLSchema(tables)
+
+			if out == "" {
+				fmt.Fprint(cmd.OutOrStdout(), schema)
+				return nil
+			}
+			if err := os.WriteFile(out, []byte(schema), 0o644); err != nil {
+				return errWithCode(ExitGenerationError, err)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&dsn, "dsn", "", "data source name of the database to introspect")
+	cmd.Flags().StringVar(&out, "out", "", "file to write the GraphQL SDL schema to (default: stdout)")
+	cmd.MarkFlagFilename("out", "graphql", "graphqls")
+
+	return cmd
+}
@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestGenGraphQLIncludesRelations(t *testing.T) {
+	dsn := newTestSQLiteFileWithSchema(t)
+
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Exec("CREATE TABLE posts (id INTEGER PRIMARY KEY, author_id INTEGER NOT NULL REFERENCES users(id))").Error; err != nil {
+		t.Fatal(err)
+	}
+
+	root := NewRootCmd()
+	var out bytes.Buffer
+	root.SetOut(&out)
+	root.SetArgs([]string{"gen", "graphql", "--dsn", dsn, "users", "posts"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(out.Bytes(), []byte("type Posts {")) {
+		t.Errorf("output = %q, want a Posts type", out.String())
+	}
+	if !bytes.Contains(out.Bytes(), []byte("author: Users")) {
+		t.Errorf("output = %q, want a nested author relation field", out.String())
+	}
+}
+
+func TestGenGraphQLRequiresDSN(t *testing.T) {
+	root := NewRootCmd()
+	root.SetArgs([]string{"gen", "graphql"})
+	if err := root.Execute(); err == nil {
+		t.Fatal("want an error when --dsn is not set")
+	}
+}
@@ -0,0 +1,132 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// newInitCmd builds the `gorm init` command, which scaffolds a
+// recommended project layout for new adopters: a models directory, an
+// example annotated query interface, a genconfig file, a migrations
+// directory, and a Makefile target wiring them together.
+func newInitCmd() *cobra.Command {
+	var dir, module string
+
+	cmd := &cobra.Command{
+		Use:   "init",
+		Short: "Scaffold a recommended project layout for gorm gen",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			reader := bufio.NewReader(cmd.InOrStdin())
+			if dir == "" {
+				dir = prompt(cmd, reader, "Project directory", ".")
+			}
+			if module == "" {
+				module = prompt(cmd, reader, "Module path", "myapp")
+			}
+			return errWithCode(ExitGenerationError, scaffoldProject(dir, module))
+		},
+	}
+
+	cmd.Flags().StringVar(&dir, "dir", "", "project directory to scaffold into (skips the prompt)")
+	cmd.Flags().StringVar(&module, "module", "", "Go module path used in generated examples (skips the prompt)")
+	cmd.MarkFlagDirname("dir")
+
+	return cmd
+}
+
+// prompt writes "label [def]: " to cmd's output and reads one line from
+// r, falling back to def on an empty line or read error (including
+// EOF, so init stays scriptable with stdin redirected from /dev/null).
+func prompt(cmd *cobra.Command, r *bufio.Reader, label, def string) string {
+	fmt.Fprintf(cmd.OutOrStdout(), "%s [%s]: ", label, def)
+	line, err := r.ReadString('\n')
+	line = strings.TrimSpace(line)
+	if err != nil || line == "" {
+		return def
+	}
+	return line
+}
+
+// scaffoldProject writes the recommended layout into dir, using module
+// in the example query interface's import comment.
+func scaffoldProject(dir, module string) error {
+	dirs := []string{
+		filepath.Join(dir, "models"),
+		filepath.Join(dir, "query"),
+		filepath.Join(dir, "migrations"),
+	}
+	for _, d := range dirs {
+		if err := os.MkdirAll(d, 0o755); err != nil {
+			return err
+		}
+	}
+
+	files := map[string]string{
+		filepath.Join(dir, "query", "query.go"): exampleQueryFile(module),
+		filepath.Join(dir, "genconfig.yaml"):    exampleGenConfig,
+	}
+	for path, content := range files {
+		if _, err := os.Stat(path); err == nil {
+			continue // don't clobber a file the user already has
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			return err
+		}
+	}
+
+	return appendMakefileTarget(filepath.Join(dir, "Makefile"))
+}
+
+func exampleQueryFile(module string) string {
+	return fmt.Sprintf(`// Package query holds the annotated interfaces gorm gen reads to
+// produce typed query code for this project's models.
+//
+// Module: %s
+package query
+
+// User is an example annotated query interface. Run `+"`gorm gen`"+` to
+// generate its implementation once a models.User struct exists.
+//
+// @@table: users
+type User interface {
+}
+`, module)
+}
+
+const exampleGenConfig = `# genconfig.yaml - settings for gorm gen in this project.
+forbidUntypedOrder: false
+`
+
+const makefileTarget = "generate:\n\tgo run github.com/go-gorm/cli/cmd/gorm gen --dsn \"$(DSN)\" --output ./query\n"
+
+// appendMakefileTarget adds a generate target to path, creating it if
+// it doesn't exist and leaving it untouched if a generate target is
+// already present.
+func appendMakefileTarget(path string) error {
+	existing, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if strings.Contains(string(existing), "generate:") {
+		return nil
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if len(existing) > 0 && !strings.HasSuffix(string(existing), "\n") {
+		if _, err := f.WriteString("\n"); err != nil {
+			return err
+		}
+	}
+	_, err = f.WriteString(makefileTarget)
+	return err
+}
@@ -0,0 +1,90 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestInitScaffoldsProject(t *testing.T) {
+	dir := t.TempDir()
+
+	root := NewRootCmd()
+	root.SetArgs([]string{"init", "--dir", dir, "--module", "example.com/widgets"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{"models", "query", "migrations"} {
+		if info, err := os.Stat(filepath.Join(dir, want)); err != nil || !info.IsDir() {
+			t.Errorf("want directory %q to exist", want)
+		}
+	}
+
+	queryContent, err := os.ReadFile(filepath.Join(dir, "query", "query.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(queryContent), "example.com/widgets") {
+		t.Errorf("query.go = %q, want it to mention the module path", queryContent)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "genconfig.yaml")); err != nil {
+		t.Error("want genconfig.yaml to exist")
+	}
+
+	makefile, err := os.ReadFile(filepath.Join(dir, "Makefile"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(makefile), "generate:") {
+		t.Errorf("Makefile = %q, want a generate target", makefile)
+	}
+}
+
+func TestInitDoesNotClobberExistingFiles(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "query"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	custom := "// custom content\npackage query\n"
+	if err := os.WriteFile(filepath.Join(dir, "query", "query.go"), []byte(custom), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	root := NewRootCmd()
+	root.SetArgs([]string{"init", "--dir", dir, "--module", "example.com/widgets"})
+	if err := root.Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := os.ReadFile(filepath.Join(dir, "query", "query.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != custom {
+		t.Errorf("query.go was overwritten, want existing content preserved")
+	}
+}
+
+func TestInitPromptsWhenFlagsOmitted(t *testing.T) {
+	dir := t.TempDir()
+
+	root := NewRootCmd()
+	root.SetIn(strings.NewReader(dir + "\nexample.com/prompted\n"))
+	root.SetArgs([]string{"init"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	queryContent, err := os.ReadFile(filepath.Join(dir, "query", "query.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(queryContent), "example.com/prompted") {
+		t.Errorf("query.go = %q, want it to mention the prompted module path", queryContent)
+	}
+}
@@ -0,0 +1,168 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/go-gorm/cli/internal/migrator"
+)
+
+// newMigrateCmd builds the `gorm migrate` command, which plans schema
+// changes against a desired DDL file.
+func newMigrateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Plan database schema migrations",
+	}
+	cmd.AddCommand(newMigratePlanCmd())
+	return cmd
+}
+
+// newMigratePlanCmd builds `gorm migrate plan`, which prints the DDL
+// statements needed to bring --dsn's schema in line with a desired
+// schema.sql file. --backend selects the planning engine: "gorm" (the
+// default) diffs columns directly; "atlas" delegates to the Atlas CLI
+// for schema features the direct diff can't represent.
+//
+// --schema-name/--env preprocess the schema file through
+// migrator.PreprocessSQL before planning against it, so the same
+// checked-in schema.sql can reference {{.Schema}}/{{.Env}} and
+// "-- include: path" directives to target a differently named schema
+// per environment.
+//
+// --expand-contract splits the plan with migrator.SplitExpandContract
+// and writes it as two files, <schema-base>.expand.sql and
+// <schema-base>.contract.sql, instead of printing one combined plan -
+// the expand file is safe to run before deploying the new application
+// version, the contract file only after.
+func newMigratePlanCmd() *cobra.Command {
+	var dsn, schema, backend, atlasPath, schemaName, env, output string
+	var expandContract bool
+
+	cmd := &cobra.Command{
+		Use:   "plan --dsn <dsn> <schema.sql>",
+		Short: "Plan the DDL statements needed to reach a desired schema",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if dsn == "" {
+				discovered, source, ok := discoverDSN(".")
+				if !ok {
+					return errWithCode(ExitUsageError, fmt.Errorf("migrate plan: --dsn is required (no DATABASE_URL, .env, or docker-compose file found to discover one from)"))
+				}
+				answer := prompt(cmd, bufio.NewReader(cmd.InOrStdin()), fmt.Sprintf("Use DSN discovered from %s? [Y/n]", source), "y")
+				if strings.HasPrefix(strings.ToLower(answer), "n") {
+					return errWithCode(ExitUsageError, fmt.Errorf("migrate plan: --dsn is required"))
+				}
+				dsn = discovered
+			}
+			schema = args[0]
+
+			if schemaName != "" || env != "" {
+				rendered, err := renderSchemaTemplate(schema, migrator.Vars{Schema: schemaName, Env: env})
+				if err != nil {
+					return errWithCode(ExitGenerationError, err)
+				}
+				schema = rendered
+			}
+
+			var b migrator.Backend
+			switch backend {
+			case "", "gorm":
+				b = migrator.GormBackend{}
+			case "atlas":
+				b = migrator.AtlasBackend{AtlasPath: atlasPath}
+			default:
+				return errWithCode(ExitUsageError, fmt.Errorf("migrate plan: unknown --backend %q, want \"gorm\" or \"atlas\"", backend))
+			}
+
+			plan, err := b.Plan(dsn, schema)
+			if err != nil {
+				return errWithCode(ExitMigrationError, err)
+			}
+
+			out := cmd.OutOrStdout()
+			if len(plan.Statements) == 0 {
+				fmt.Fprintln(out, "-- schema is up to date, nothing to do")
+				return nil
+			}
+
+			if expandContract {
+				return writeExpandContractPlan(out, plan, args[0], output)
+			}
+			for _, stmt := range plan.Statements {
+				fmt.Fprintln(out, stmt+";")
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&dsn, "dsn", "", "data source name of the database to plan against")
+	cmd.Flags().StringVar(&backend, "backend", "gorm", `planning backend: "gorm" or "atlas"`)
+	cmd.Flags().StringVar(&atlasPath, "atlas-path", "", `path to the atlas binary when --backend=atlas (default: "atlas" on PATH)`)
+	cmd.Flags().StringVar(&schemaName, "schema-name", "", "value of {{.Schema}} when preprocessing the schema file")
+	cmd.Flags().StringVar(&env, "env", "", "value of {{.Env}} when preprocessing the schema file")
+	cmd.Flags().BoolVar(&expandContract, "expand-contract", false, "split the plan into expand/contract phase files for a zero-downtime rollout")
+	cmd.Flags().StringVarP(&output, "output", "o", ".", "directory to write expand/contract files to (with --expand-contract)")
+	cmd.MarkFlagDirname("output")
+
+	return cmd
+}
+
+// writeExpandContractPlan splits plan into expand/contract phases and
+// writes each to its own file alongside schema's base name, reporting
+// what it wrote to out.
+func writeExpandContractPlan(out io.Writer, plan migrator.Plan, schema, output string) error {
+	expand, contract := migrator.SplitExpandContract(plan)
+	base := strings.TrimSuffix(filepath.Base(schema), filepath.Ext(schema))
+
+	for _, phase := range []struct {
+		name string
+		plan migrator.Plan
+	}{
+		{"expand", expand},
+		{"contract", contract},
+	} {
+		path := filepath.Join(output, fmt.Sprintf("%s.%s.sql", base, phase.name))
+		var body strings.Builder
+		for _, stmt := range phase.plan.Statements {
+			body.WriteString(stmt)
+			body.WriteString(";\n")
+		}
+		if err := os.WriteFile(path, []byte(body.String()), 0o644); err != nil {
+			return errWithCode(ExitGenerationError, err)
+		}
+		fmt.Fprintf(out, "wrote %d statement(s) to %s\n", len(phase.plan.Statements), path)
+	}
+	return nil
+}
+
+// renderSchemaTemplate preprocesses the schema file at path through
+// migrator.PreprocessSQL and writes the result to a temp file, so
+// Backend.Plan - which reads its schemaPath argument directly - sees
+// the same rendered SQL a person running the migration by hand would.
+func renderSchemaTemplate(path string, vars migrator.Vars) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	rendered, err := migrator.PreprocessSQL(string(data), vars, filepath.Dir(path))
+	if err != nil {
+		return "", err
+	}
+
+	tmp, err := os.CreateTemp("", "gorm-migrate-schema-*.sql")
+	if err != nil {
+		return "", err
+	}
+	defer tmp.Close()
+	if _, err := tmp.WriteString(rendered); err != nil {
+		return "", err
+	}
+	return tmp.Name(), nil
+}
@@ -0,0 +1,142 @@
+package cli
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// chdir changes the working directory to dir for the duration of the
+// test, restoring it on cleanup.
+func chdir(t *testing.T, dir string) {
+	t.Helper()
+	prev, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(prev) })
+}
+
+func TestMigratePlanGormBackend(t *testing.T) {
+	dsn := newTestSQLiteFileWithSchema(t)
+	schema := filepath.Join(t.TempDir(), "schema.sql")
+	sql := `CREATE TABLE users (id INTEGER NOT NULL PRIMARY KEY, name TEXT NOT NULL, nickname TEXT, bio TEXT);`
+	if err := os.WriteFile(schema, []byte(sql), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	root := NewRootCmd()
+	var out bytes.Buffer
+	root.SetOut(&out)
+	root.SetArgs([]string{"migrate", "plan", "--dsn", dsn, schema})
+
+	if err := root.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(out.Bytes(), []byte(`ALTER TABLE "users" ADD COLUMN "bio" TEXT;`)) {
+		t.Errorf("output = %q, want an ADD COLUMN bio statement", out.String())
+	}
+}
+
+func TestMigratePlanRequiresDSN(t *testing.T) {
+	t.Setenv("DATABASE_URL", "")
+	chdir(t, t.TempDir())
+
+	root := NewRootCmd()
+	root.SetArgs([]string{"migrate", "plan", "schema.sql"})
+	if err := root.Execute(); err == nil {
+		t.Fatal("want an error when --dsn is not set and nothing is discoverable")
+	}
+}
+
+func TestMigratePlanDiscoversDSN(t *testing.T) {
+	dsn := newTestSQLiteFileWithSchema(t)
+	schema := filepath.Join(t.TempDir(), "schema.sql")
+	sql := `CREATE TABLE users (id INTEGER NOT NULL PRIMARY KEY, name TEXT NOT NULL, nickname TEXT);`
+	if err := os.WriteFile(schema, []byte(sql), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	chdir(t, t.TempDir())
+	t.Setenv("DATABASE_URL", dsn)
+
+	root := NewRootCmd()
+	root.SetIn(strings.NewReader("y\n"))
+	var out bytes.Buffer
+	root.SetOut(&out)
+	root.SetArgs([]string{"migrate", "plan", schema})
+
+	if err := root.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "up to date") {
+		t.Errorf("output = %q, want the discovered DSN to be used", out.String())
+	}
+}
+
+func TestMigratePlanRendersSchemaTemplate(t *testing.T) {
+	dsn := newTestSQLiteFileWithSchema(t)
+	schema := filepath.Join(t.TempDir(), "schema.sql")
+	sql := `CREATE TABLE {{if eq .Env "prod"}}users{{else}}users_staging{{end}} (id INTEGER NOT NULL PRIMARY KEY, name TEXT NOT NULL, nickname TEXT, bio TEXT);`
+	if err := os.WriteFile(schema, []byte(sql), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	root := NewRootCmd()
+	var out bytes.Buffer
+	root.SetOut(&out)
+	root.SetArgs([]string{"migrate", "plan", "--dsn", dsn, "--env", "prod", schema})
+
+	if err := root.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(out.Bytes(), []byte(`ALTER TABLE "users" ADD COLUMN "bio" TEXT;`)) {
+		t.Errorf("output = %q, want the rendered users table's ADD COLUMN bio statement", out.String())
+	}
+}
+
+func TestMigratePlanExpandContract(t *testing.T) {
+	dsn := newTestSQLiteFileWithSchema(t)
+	dir := t.TempDir()
+	schema := filepath.Join(dir, "schema.sql")
+	sql := `CREATE TABLE users (id INTEGER NOT NULL PRIMARY KEY, name TEXT NOT NULL, nickname TEXT, verified BOOLEAN NOT NULL);`
+	if err := os.WriteFile(schema, []byte(sql), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	root := NewRootCmd()
+	root.SetArgs([]string{"migrate", "plan", "--dsn", dsn, "--expand-contract", "--output", dir, schema})
+	if err := root.Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	expand, err := os.ReadFile(filepath.Join(dir, "schema.expand.sql"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	contract, err := os.ReadFile(filepath.Join(dir, "schema.contract.sql"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(contract), `ADD COLUMN "verified" BOOLEAN NOT NULL`) {
+		t.Errorf("contract = %q, want the NOT NULL column addition", contract)
+	}
+	if strings.Contains(string(expand), "verified") {
+		t.Errorf("expand = %q, want the NOT NULL column addition kept out of expand", expand)
+	}
+}
+
+func TestMigratePlanUnknownBackend(t *testing.T) {
+	dsn := newTestSQLiteFileWithSchema(t)
+	root := NewRootCmd()
+	root.SetArgs([]string{"migrate", "plan", "--dsn", dsn, "--backend", "bogus", "schema.sql"})
+	if err := root.Execute(); err == nil {
+		t.Fatal("want an error for an unknown --backend")
+	}
+}
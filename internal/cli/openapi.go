@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/go-gorm/cli/internal/generator"
+)
+
+// newGenOpenAPICmd builds `gorm gen openapi`, which converts processed
+// model structs (column types, nullability) into OpenAPI schema
+// components, so API docs generated from the file stay in sync with the
+// database models instead of drifting out of date by hand.
+func newGenOpenAPICmd() *cobra.Command {
+	var dsn, out string
+
+	cmd := &cobra.Command{
+		Use:   "openapi [tables...]",
+		Short: "Export model structs as OpenAPI schema components",
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if dsn == "" {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			tables, err := listTables(dsn)
+			if err != nil {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			return tables, cobra.ShellCompDirectiveNoFileComp
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if dsn == "" {
+				return errWithCode(ExitUsageError, fmt.Errorf("openapi: --dsn is required"))
+			}
+
+			names := args
+			if len(names) == 0 {
+				var err error
+				names, err = listTables(dsn)
+				if err != nil {
+					return errWithCode(ExitGenerationError, err)
+				}
+			}
+
+			tables := make([]generator.Table, len(names))
+			for i, name := range names {
+				t, err := loadTable(dsn, name)
+				if err != nil {
+					return errWithCode(ExitGenerationError, err)
+				}
+				tables[i] = t
+			}
+
+			doc := generator.BuildOpenAPIDocument(tables)
+
+			if out == "" {
+				enc := yaml.NewEncoder(cmd.OutOrStdout())
+				defer enc.Close()
+				return enc.Encode(doc)
+			}
+
+			data, err := yaml.Marshal(doc)
+			if err != nil {
+				return errWithCode(ExitGenerationError, err)
+			}
+			if err := os.WriteFile(out, data, 0o644); err != nil {
+				return errWithCode(ExitGenerationError, err)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&dsn, "dsn", "", "data source name of the database to introspect")
+	cmd.Flags().StringVar(&out, "out", "", "file to write the OpenAPI document to (default: stdout)")
+	cmd.MarkFlagFilename("out", "yaml", "yml")
+
+	return cmd
+}
@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+)
+
+func newTestSQLiteFileWithSchema(t *testing.T) string {
+	t.Helper()
+	path := newTestSQLiteFile(t)
+	db, err := gorm.Open(sqlite.Open(path), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Exec("ALTER TABLE users ADD COLUMN name TEXT NOT NULL DEFAULT ''").Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Exec("ALTER TABLE users ADD COLUMN nickname TEXT").Error; err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestGenOpenAPIStdout(t *testing.T) {
+	dsn := newTestSQLiteFileWithSchema(t)
+
+	root := NewRootCmd()
+	var out bytes.Buffer
+	root.SetOut(&out)
+	root.SetArgs([]string{"gen", "openapi", "--dsn", dsn, "users"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(out.Bytes(), []byte("Users:")) {
+		t.Errorf("output = %q, want a Users schema", out.String())
+	}
+	if !bytes.Contains(out.Bytes(), []byte("nickname")) {
+		t.Errorf("output = %q, want the nickname column", out.String())
+	}
+}
+
+func TestGenOpenAPIWritesFile(t *testing.T) {
+	dsn := newTestSQLiteFileWithSchema(t)
+	out := filepath.Join(t.TempDir(), "openapi.yaml")
+
+	root := NewRootCmd()
+	root.SetOut(&bytes.Buffer{})
+	root.SetArgs([]string{"gen", "openapi", "--dsn", dsn, "--out", out, "users"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(data, []byte("openapi:")) {
+		t.Errorf("file contents = %q, want an openapi document", data)
+	}
+}
+
+func TestGenOpenAPIRequiresDSN(t *testing.T) {
+	root := NewRootCmd()
+	root.SetArgs([]string{"gen", "openapi"})
+	if err := root.Execute(); err == nil {
+		t.Fatal("want an error when --dsn is not set")
+	}
+}
@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// pluginPrefix is prepended to the requested subcommand name to form the
+// binary gorm looks for on PATH, kubectl/git style (gorm foo -> gorm-foo).
+const pluginPrefix = "gorm-"
+
+// resolvePlugin reports the PATH-resolved binary for gorm <name>, and
+// whether name should be dispatched to it at all: name must not already
+// be one of root's built-in subcommands (including cobra's own help and
+// completion) and must not look like a flag.
+func resolvePlugin(root *cobra.Command, name string) (string, bool) {
+	if name == "" || strings.HasPrefix(name, "-") {
+		return "", false
+	}
+	for _, c := range root.Commands() {
+		if c.Name() == name || c.HasAlias(name) {
+			return "", false
+		}
+	}
+
+	path, err := exec.LookPath(pluginPrefix + name)
+	if err != nil {
+		return "", false
+	}
+	return path, true
+}
+
+// runPlugin execs path with args, wiring up the current process's
+// stdio, and maps its exit code onto a CodedError so main can exit with
+// the same code the plugin itself returned.
+func runPlugin(path string, args []string) error {
+	cmd := exec.Command(path, args...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	err := cmd.Run()
+	if err == nil {
+		return nil
+	}
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		return errWithCode(exitErr.ExitCode(), err)
+	}
+	return errWithCode(ExitGenerationError, err)
+}
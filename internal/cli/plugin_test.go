@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestResolvePluginSkipsBuiltins(t *testing.T) {
+	root := NewRootCmd()
+	root.InitDefaultCompletionCmd()
+
+	for _, name := range []string{"gen", "doctor", "init", "completion", "help", "", "-v"} {
+		if _, ok := resolvePlugin(root, name); ok {
+			t.Errorf("resolvePlugin(%q) = ok, want not dispatched to a plugin", name)
+		}
+	}
+}
+
+func writeFakePlugin(t *testing.T, dir, name, script string) {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte("#!/bin/sh\n"+script), 0o755); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestResolvePluginFindsBinaryOnPATH(t *testing.T) {
+	dir := t.TempDir()
+	writeFakePlugin(t, dir, "gorm-hello", "exit 0\n")
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	root := NewRootCmd()
+	path, ok := resolvePlugin(root, "hello")
+	if !ok {
+		t.Fatal("want resolvePlugin to find gorm-hello on PATH")
+	}
+	if filepath.Dir(path) != dir {
+		t.Errorf("path = %q, want it resolved from %q", path, dir)
+	}
+}
+
+func TestRunPluginPropagatesExitCode(t *testing.T) {
+	dir := t.TempDir()
+	writeFakePlugin(t, dir, "gorm-failer", "exit 7\n")
+
+	err := runPlugin(filepath.Join(dir, "gorm-failer"), nil)
+	if err == nil {
+		t.Fatal("want an error for a non-zero exit")
+	}
+	if got := ExitCode(err); got != 7 {
+		t.Errorf("ExitCode = %d, want 7", got)
+	}
+}
+
+func TestRunPluginForwardsArgs(t *testing.T) {
+	dir := t.TempDir()
+	writeFakePlugin(t, dir, "gorm-echo", `[ "$1" = "arg1" ] && exit 0 || exit 1`+"\n")
+
+	if err := runPlugin(filepath.Join(dir, "gorm-echo"), []string{"arg1"}); err != nil {
+		t.Errorf("want success when argv[1] matches, got %v", err)
+	}
+}
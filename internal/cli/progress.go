@@ -0,0 +1,30 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"time"
+)
+
+// progressReporter prints per-item progress with counts and elapsed
+// time during long `gorm gen` runs, so a multi-minute run on a big
+// schema doesn't look hung. It writes to a separate stream from the
+// command's result output (stderr, by convention), so --format json
+// output on stdout stays machine-parseable.
+type progressReporter struct {
+	out   io.Writer
+	start time.Time
+	total int
+}
+
+// newProgressReporter builds a progressReporter that reports against
+// total items, writing to out. Pass io.Discard for out to silence it
+// (what --quiet does).
+func newProgressReporter(out io.Writer, total int) *progressReporter {
+	return &progressReporter{out: out, start: time.Now(), total: total}
+}
+
+// step reports that item i (1-based) of total, named name, just finished.
+func (p *progressReporter) step(i int, name string) {
+	fmt.Fprintf(p.out, "[%d/%d] %s (%s elapsed)\n", i, p.total, name, time.Since(p.start).Round(time.Millisecond))
+}
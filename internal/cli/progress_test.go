@@ -0,0 +1,19 @@
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestProgressReporterStep(t *testing.T) {
+	var buf bytes.Buffer
+	p := newProgressReporter(&buf, 2)
+	p.step(1, "users")
+	p.step(2, "posts")
+
+	got := buf.String()
+	if !strings.Contains(got, "[1/2] users") || !strings.Contains(got, "[2/2] posts") {
+		t.Errorf("output = %q, want progress lines for both steps", got)
+	}
+}
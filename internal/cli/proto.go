@@ -0,0 +1,119 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/go-gorm/cli/internal/generator"
+)
+
+// newGenProtoCmd builds `gorm gen proto`, which maps model structs to
+// .proto messages for gRPC services that mirror persistence models.
+// Field numbers are read from and written back to --state, so adding or
+// removing a column doesn't renumber fields that already shipped on the
+// wire.
+func newGenProtoCmd() *cobra.Command {
+	var dsn, out, state, pkg string
+
+	cmd := &cobra.Command{
+		Use:   "proto [tables...]",
+		Short: "Export model structs as .proto messages",
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if dsn == "" {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			tables, err := listTables(dsn)
+			if err != nil {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			return tables, cobra.ShellCompDirectiveNoFileComp
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if dsn == "" {
+				return errWithCode(ExitUsageError, fmt.Errorf("proto: --dsn is required"))
+			}
+
+			names := args
+			if len(names) == 0 {
+				var err error
+				names, err = listTables(dsn)
+				if err != nil {
+					return errWithCode(ExitGenerationError, err)
+				}
+			}
+
+			tables := make([]generator.Table, len(names))
+			for i, name := range names {
+				t, err := loadTable(dsn, name)
+				if err != nil {
+					return errWithCode(ExitGenerationError, err)
+				}
+				tables[i] = t
+			}
+
+			prior, err := loadProtoFieldNumbers(state)
+			if err != nil {
+				return errWithCode(ExitGenerationError, err)
+			}
+
+			numbers := generator.AssignProtoFieldNumbers(tables, prior)
+			proto := generator.RenderProto(tables, numbers, pkg)
+
+			if state != "" {
+				if err := writeProtoFieldNumbers(state, numbers); err != nil {
+					return errWithCode(ExitGenerationError, err)
+				}
+			}
+
+			if out == "" {
+				fmt.Fprint(cmd.OutOrStdout(), proto)
+				return nil
+			}
+			if err := os.WriteFile(out, []byte(proto), 0o644); err != nil {
+				return errWithCode(ExitGenerationError, err)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&dsn, "dsn", "", "data source name of the database to introspect")
+	cmd.Flags().StringVar(&out, "out", "", "file to write the .proto document to (default: stdout)")
+	cmd.Flags().StringVar(&state, "state", "", "field-number state file to read from and update, for stable field numbers across runs")
+	cmd.Flags().StringVar(&pkg, "package", "models", "proto package name for the generated file")
+	cmd.MarkFlagFilename("out", "proto")
+	cmd.MarkFlagFilename("state", "json")
+
+	return cmd
+}
+
+// loadProtoFieldNumbers reads a field-number state file, returning an
+// empty ProtoFieldNumbers if path is unset or doesn't exist yet (the
+// first run for a project).
+func loadProtoFieldNumbers(path string) (generator.ProtoFieldNumbers, error) {
+	if path == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return generator.ProtoFieldNumbers{}, nil
+		}
+		return nil, err
+	}
+	var numbers generator.ProtoFieldNumbers
+	if err := json.Unmarshal(data, &numbers); err != nil {
+		return nil, fmt.Errorf("proto: parsing %s: %w", path, err)
+	}
+	return numbers, nil
+}
+
+func writeProtoFieldNumbers(path string, numbers generator.ProtoFieldNumbers) error {
+	data, err := json.MarshalIndent(numbers, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
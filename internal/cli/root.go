@@ -0,0 +1,82 @@
+// Package cli wires up the gorm command's cobra commands. It is kept
+// separate from cmd/gorm so the command tree can be unit tested without
+// going through a subprocess.
+package cli
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// NewRootCmd builds the gorm command tree.
+//
+// --timeout and SIGINT/SIGTERM both cancel the running command's
+// context (root.Context()): the persistent pre/post run hooks below
+// wrap whatever context the command was executed with in
+// signal.NotifyContext, and in context.WithTimeout when --timeout is
+// set, so a command that checks ctx.Done() between units of work (see
+// errFromContext) finishes what it's doing and exits with a distinct
+// code (ExitTimeout/ExitInterrupted) instead of leaving things
+// half-applied.
+func NewRootCmd() *cobra.Command {
+	var timeout time.Duration
+	var cancel context.CancelFunc
+
+	root := &cobra.Command{
+		Use:           "gorm",
+		Short:         "gorm is the CLI for the GORM generator and related tooling",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		PersistentPostRun: func(cmd *cobra.Command, args []string) {
+			if cancel != nil {
+				cancel()
+			}
+		},
+	}
+
+	root.PersistentFlags().Bool("no-color", false, "disable colorized output even when attached to a terminal")
+	root.PersistentFlags().DurationVar(&timeout, "timeout", 0, "cancel the command if it hasn't finished within this duration (0 disables)")
+
+	root.AddCommand(newGenCmd())
+	root.AddCommand(newDoctorCmd())
+	root.AddCommand(newInitCmd())
+	root.AddCommand(newMigrateCmd())
+
+	bindConfigFlag(root, newViper(), func(cmd *cobra.Command) {
+		ctx, stop := signal.NotifyContext(cmd.Context(), syscall.SIGINT, syscall.SIGTERM)
+		if timeout > 0 {
+			var cancelTimeout context.CancelFunc
+			ctx, cancelTimeout = context.WithTimeout(ctx, timeout)
+			cancel = func() { cancelTimeout(); stop() }
+		} else {
+			cancel = stop
+		}
+		cmd.SetContext(ctx)
+	})
+
+	return root
+}
+
+// Execute runs the gorm command tree against os.Args. If the requested
+// subcommand isn't one of gorm's own, it's dispatched to a gorm-<name>
+// binary on PATH instead, kubectl/git style, so private extensions
+// (custom generators, company migration policies) feel native to the CLI
+// without gorm needing to know about them at compile time.
+func Execute() error {
+	root := NewRootCmd()
+	root.InitDefaultHelpCmd()
+	root.InitDefaultCompletionCmd()
+
+	if len(os.Args) > 1 {
+		if path, ok := resolvePlugin(root, os.Args[1]); ok {
+			return runPlugin(path, os.Args[2:])
+		}
+	}
+
+	return root.Execute()
+}
@@ -0,0 +1,26 @@
+package cli
+
+import "testing"
+
+func TestNewRootCmdHasCompletion(t *testing.T) {
+	root := NewRootCmd()
+
+	if _, _, err := root.Find([]string{"gen"}); err != nil {
+		t.Fatalf("gen command not registered: %v", err)
+	}
+
+	root.InitDefaultCompletionCmd()
+	if _, _, err := root.Find([]string{"completion"}); err != nil {
+		t.Fatalf("completion command not registered: %v", err)
+	}
+}
+
+func TestListTablesEmptyDB(t *testing.T) {
+	tables, err := listTables(":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tables) != 0 {
+		t.Errorf("tables = %v, want none in a fresh in-memory db", tables)
+	}
+}
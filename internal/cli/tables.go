@@ -0,0 +1,19 @@
+package cli
+
+import (
+	"github.com/go-gorm/cli/internal/generator"
+)
+
+// listTables returns the table names visible in the sqlite database at
+// dsn, for completing table-name arguments without the user having to
+// remember their schema by hand.
+func listTables(dsn string) ([]string, error) {
+	return generator.ListTables(dsn)
+}
+
+// loadTable introspects table's columns (name, declared SQL type,
+// nullability) via PRAGMA table_info, the same catalog gen itself would
+// read from to decide each column's generated Go type.
+func loadTable(dsn, table string) (generator.Table, error) {
+	return generator.LoadTable(dsn, table)
+}
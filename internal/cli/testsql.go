@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/go-gorm/cli/internal/generator"
+)
+
+// newGenTestSQLCmd builds `gorm gen test-sql`, which renders each
+// table's typed lookup and insert helpers against sqlite/mysql/postgres
+// dialectors in DryRun and writes the resulting SQL to one golden file
+// per table, so a change to a query shows up as a reviewable SQL diff
+// across every dialect instead of surfacing later against a real
+// database.
+func newGenTestSQLCmd() *cobra.Command {
+	var dsn, output string
+
+	cmd := &cobra.Command{
+		Use:   "test-sql [tables...]",
+		Short: "Write golden SQL files for one or more tables across sqlite/mysql/postgres",
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if dsn == "" {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			tables, err := listTables(dsn)
+			if err != nil {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			return tables, cobra.ShellCompDirectiveNoFileComp
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if dsn == "" {
+				return errWithCode(ExitUsageError, fmt.Errorf("test-sql: --dsn is required"))
+			}
+
+			names := args
+			if len(names) == 0 {
+				var err error
+				names, err = listTables(dsn)
+				if err != nil {
+					return errWithCode(ExitGenerationError, err)
+				}
+			}
+
+			for _, name := range names {
+				table, err := loadTable(dsn, name)
+				if err != nil {
+					return errWithCode(ExitGenerationError, err)
+				}
+
+				golden, err := generator.RenderDialectGolden(table)
+				if err != nil {
+					return errWithCode(ExitGenerationError, err)
+				}
+
+				for _, dialect := range generator.DialectNames() {
+					path := filepath.Join(output, fmt.Sprintf("%s_%s.golden.sql", name, dialect))
+					if err := os.WriteFile(path, []byte(golden[dialect]), 0o644); err != nil {
+						return errWithCode(ExitGenerationError, err)
+					}
+				}
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&dsn, "dsn", "", "data source name of the database to introspect")
+	cmd.Flags().StringVarP(&output, "output", "o", ".", "directory to write golden SQL files to")
+	cmd.MarkFlagDirname("output")
+
+	return cmd
+}
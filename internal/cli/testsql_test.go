@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGenTestSQLWritesGoldenFiles(t *testing.T) {
+	dsn := newTestSQLiteFileWithSchema(t)
+	dir := t.TempDir()
+
+	root := NewRootCmd()
+	root.SetArgs([]string{"gen", "test-sql", "--dsn", dsn, "--output", dir, "users"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	sqlite, err := os.ReadFile(filepath.Join(dir, "users_sqlite.golden.sql"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(sqlite), `"users"`) {
+		t.Errorf("sqlite golden = %q, want a double-quoted users identifier", sqlite)
+	}
+
+	postgres, err := os.ReadFile(filepath.Join(dir, "users_postgres.golden.sql"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(postgres), "$1") {
+		t.Errorf("postgres golden = %q, want a $1 placeholder", postgres)
+	}
+
+	mysql, err := os.ReadFile(filepath.Join(dir, "users_mysql.golden.sql"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(mysql), "`users`") {
+		t.Errorf("mysql golden = %q, want a backtick-quoted users identifier", mysql)
+	}
+}
+
+func TestGenTestSQLRequiresDSN(t *testing.T) {
+	root := NewRootCmd()
+	root.SetArgs([]string{"gen", "test-sql"})
+	if err := root.Execute(); err == nil {
+		t.Fatal("want an error when --dsn is not set")
+	}
+}
@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/go-gorm/cli/internal/generator"
+)
+
+// newGenTypeScriptCmd builds `gorm gen typescript`, which exports model
+// structs as TypeScript interfaces, so a full-stack repo's frontend
+// types can be regenerated from the same schema as the backend models.
+func newGenTypeScriptCmd() *cobra.Command {
+	var dsn, out string
+
+	cmd := &cobra.Command{
+		Use:   "typescript [tables...]",
+		Short: "Export model structs as TypeScript interfaces",
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if dsn == "" {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			tables, err := listTables(dsn)
+			if err != nil {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			return tables, cobra.ShellCompDirectiveNoFileComp
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if dsn == "" {
+				return errWithCode(ExitUsageError, fmt.Errorf("typescript: --dsn is required"))
+			}
+
+			names := args
+			if len(names) == 0 {
+				var err error
+				names, err = listTables(dsn)
+				if err != nil {
+					return errWithCode(ExitGenerationError, err)
+				}
+			}
+
+			tables := make([]generator.Table, len(names))
+			for i, name := range names {
+				t, err := loadTable(dsn, name)
+				if err != nil {
+					return errWithCode(ExitGenerationError, err)
+				}
+				tables[i] = t
+			}
+
+			code := generator.RenderTypeScript(tables)
+
+			if out == "" {
+				fmt.Fprint(cmd.OutOrStdout(), code)
+				return nil
+			}
+			if err := os.WriteFile(out, []byte(code), 0o644); err != nil {
+				return errWithCode(ExitGenerationError, err)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&dsn, "dsn", "", "data source name of the database to introspect")
+	cmd.Flags().StringVar(&out, "out", "", "file to write the TypeScript interfaces to (default: stdout)")
+	cmd.MarkFlagFilename("out", "ts")
+
+	return cmd
+}
@@ -0,0 +1,33 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestGenTypeScriptStdout(t *testing.T) {
+	dsn := newTestSQLiteFileWithSchema(t)
+
+	root := NewRootCmd()
+	var out bytes.Buffer
+	root.SetOut(&out)
+	root.SetArgs([]string{"gen", "typescript", "--dsn", dsn, "users"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(out.Bytes(), []byte("export interface Users {")) {
+		t.Errorf("output = %q, want a Users interface", out.String())
+	}
+	if !bytes.Contains(out.Bytes(), []byte("nickname: string | null;")) {
+		t.Errorf("output = %q, want a nullable nickname field", out.String())
+	}
+}
+
+func TestGenTypeScriptRequiresDSN(t *testing.T) {
+	root := NewRootCmd()
+	root.SetArgs([]string{"gen", "typescript"})
+	if err := root.Execute(); err == nil {
+		t.Fatal("want an error when --dsn is not set")
+	}
+}
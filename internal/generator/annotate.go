@@ -0,0 +1,98 @@
+package generator
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/go-gorm/cli/internal/pkgcache"
+)
+
+// AnnotatedInterfaces returns the names of every interface type
+// declared in the Go source file at path, sorted, for `gorm gen -i` to
+// treat as its table argument list without opening a database
+// connection at all - the single-file mode a go:generate directive
+// like `//go:generate gorm gen -i $GOFILE -o ./gen` needs.
+//
+// A package can carry many such directives, one per file, each
+// re-parsing on every `go generate` run. To keep that cheap,
+// AnnotatedInterfaces caches its result under pkgcache, keyed by the
+// target module's go.mod/go.sum together with path's own content, so
+// an unchanged file in an unchanged module skips the parse.
+func AnnotatedInterfaces(path string) ([]string, error) {
+	key, err := annotationCacheKey(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var cached []string
+	if ok, _ := pkgcache.Load(key, &cached); ok {
+		return cached, nil
+	}
+
+	names, err := parseInterfaceNames(path)
+	if err != nil {
+		return nil, err
+	}
+
+	_ = pkgcache.Store(key, names)
+	return names, nil
+}
+
+// parseInterfaceNames parses path as a standalone file - it doesn't
+// need type information, just the declared interface names - so it
+// never pays for a full go/packages.Load of path's package and its
+// dependencies the way ResolveTableName does.
+func parseInterfaceNames(path string) ([]string, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			ts, ok := spec.(*ast.TypeSpec)
+			if !ok {
+				continue
+			}
+			if _, ok := ts.Type.(*ast.InterfaceType); ok {
+				names = append(names, ts.Name.Name)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// annotationCacheKey combines path's enclosing module's go.mod/go.sum
+// hash with path's own content hash, so the cache invalidates when
+// either the module's dependencies or the file itself changes.
+func annotationCacheKey(path string) (string, error) {
+	goMod, err := findGoMod(filepath.Dir(path))
+	if err != nil {
+		return "", err
+	}
+
+	moduleKey, err := pkgcache.ModuleKey(filepath.Dir(goMod))
+	if err != nil {
+		return "", err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	fileHash := sha256.Sum256(data)
+	return "gen-i-" + moduleKey + "-" + hex.EncodeToString(fileHash[:]), nil
+}
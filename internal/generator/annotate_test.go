@@ -0,0 +1,113 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeAnnotatedModule(t *testing.T, src string) string {
+	t.Helper()
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	dir := t.TempDir()
+	goMod := "module annotatetest\n\ngo 1.22.0\n"
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte(goMod), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "queries.go")
+	if err := os.WriteFile(path, []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestAnnotatedInterfacesFindsEveryInterface(t *testing.T) {
+	path := writeAnnotatedModule(t, `package query
+
+type Users interface {
+	GetByID(id int) (User, error)
+}
+
+type Orders interface {
+	GetByID(id int) (Order, error)
+}
+
+type User struct{}
+type Order struct{}
+`)
+
+	names, err := AnnotatedInterfaces(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(names) != 2 || names[0] != "Orders" || names[1] != "Users" {
+		t.Errorf("names = %v, want [Orders Users]", names)
+	}
+}
+
+func TestAnnotatedInterfacesCachesAcrossCalls(t *testing.T) {
+	path := writeAnnotatedModule(t, `package query
+
+type Users interface {
+	GetByID(id int) (User, error)
+}
+
+type User struct{}
+`)
+
+	first, err := AnnotatedInterfaces(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Neither the module's go.mod/go.sum nor the file changes between
+	// the two calls, so the second call is served from the cache entry
+	// the first stored and must return the same names.
+	second, err := AnnotatedInterfaces(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(first) != len(second) || first[0] != second[0] {
+		t.Errorf("second call = %v, want the same result as the first %v", second, first)
+	}
+}
+
+func TestAnnotatedInterfacesInvalidatesOnFileChange(t *testing.T) {
+	path := writeAnnotatedModule(t, `package query
+
+type Users interface {
+	GetByID(id int) (User, error)
+}
+
+type User struct{}
+`)
+
+	if _, err := AnnotatedInterfaces(path); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(path, []byte(`package query
+
+type Users interface {
+	GetByID(id int) (User, error)
+}
+
+type Orders interface {
+	GetByID(id int) (Order, error)
+}
+
+type User struct{}
+type Order struct{}
+`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	names, err := AnnotatedInterfaces(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(names) != 2 {
+		t.Errorf("names = %v, want the updated file's 2 interfaces, not a stale cache hit", names)
+	}
+}
@@ -0,0 +1,56 @@
+package generator
+
+import "fmt"
+
+// RenderBenchmarks returns the generated benchmark/example stub file
+// gen would emit for table, using the default Config. Like Render, the
+// output is a template keyed only off the table name - a query.Users
+// wrapping model.Users assumed already generated alongside it.
+func RenderBenchmarks(table string) string {
+	return RenderBenchmarksWithConfig(table, Config{})
+}
+
+// RenderBenchmarksWithConfig is RenderBenchmarks with cfg's settings
+// applied, e.g. VariableNamePrefix/VariableNameSuffix on the accessor
+// the stubs call through.
+//
+// The file carries a gen_bench build tag so these stubs never run as
+// part of an ordinary `go test ./...`; a team opts in with
+// `go test -tags gen_bench -bench .` when they want to track query-layer
+// performance regressions.
+func RenderBenchmarksWithConfig(table string, cfg Config) string {
+	accessor := cfg.HelperIdentifier(table)
+	name := structName(table)
+
+	return fmt.Sprintf(`//go:build gen_bench
+
+// Code generated by gorm gen. DO NOT EDIT.
+
+package query
+
+import "testing"
+
+// Example%s demonstrates a basic query against %s.
+func Example%s() {
+	_, _ = %s.First()
+}
+
+func Benchmark%s_First(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = %s.First()
+	}
+}
+
+func Benchmark%s_Count(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = %s.Count()
+	}
+}
+
+func Benchmark%s_Delete(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = %s.Delete()
+	}
+}
+`, name, accessor, name, accessor, name, accessor, name, accessor, name, accessor)
+}
@@ -0,0 +1,36 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderBenchmarks(t *testing.T) {
+	code := RenderBenchmarks("users")
+
+	if !strings.Contains(code, "//go:build gen_bench") {
+		t.Errorf("code = %q, want a gen_bench build tag", code)
+	}
+	if !strings.Contains(code, "func ExampleUsers() {") {
+		t.Errorf("code = %q, want an ExampleUsers", code)
+	}
+	if !strings.Contains(code, "func BenchmarkUsers_First(b *testing.B) {") {
+		t.Errorf("code = %q, want a BenchmarkUsers_First", code)
+	}
+	if !strings.Contains(code, "users.First()") {
+		t.Errorf("code = %q, want stubs calling the users accessor", code)
+	}
+}
+
+func TestRenderBenchmarksWithConfigAppliesVariableNameOverrides(t *testing.T) {
+	code := RenderBenchmarksWithConfig("users", Config{VariableNameSuffix: "Fields"})
+	if !strings.Contains(code, "usersFields.First()") {
+		t.Errorf("code = %q, want the usersFields accessor", code)
+	}
+}
+
+func TestRenderBenchmarksUsesDefaultConfig(t *testing.T) {
+	if RenderBenchmarks("users") != RenderBenchmarksWithConfig("users", Config{}) {
+		t.Error("RenderBenchmarks should match RenderBenchmarksWithConfig with the zero Config")
+	}
+}
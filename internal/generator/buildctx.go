@@ -0,0 +1,43 @@
+package generator
+
+import "go/build"
+
+// BuildContext controls which //go:build-constrained input files gen
+// considers when scanning a package for model and query-interface
+// definitions. It mirrors the handful of go/build.Context fields a
+// project actually needs to set: GOOS/GOARCH pins the target platform,
+// and BuildTags adds custom tags, so a file with a platform-specific
+// variant (e.g. model_linux.go / model_windows.go) contributes exactly
+// one set of generated symbols instead of gen reading both and
+// producing duplicates or conflicting output.
+type BuildContext struct {
+	GOOS      string   `yaml:"goos,omitempty"`
+	GOARCH    string   `yaml:"goarch,omitempty"`
+	BuildTags []string `yaml:"buildTags,omitempty"`
+}
+
+// context builds the go/build.Context MatchFile evaluates constraints
+// against, defaulting any unset field to the running toolchain's own
+// build.Default.
+func (b BuildContext) context() build.Context {
+	ctx := build.Default
+	if b.GOOS != "" {
+		ctx.GOOS = b.GOOS
+	}
+	if b.GOARCH != "" {
+		ctx.GOARCH = b.GOARCH
+	}
+	if len(b.BuildTags) > 0 {
+		ctx.BuildTags = b.BuildTags
+	}
+	return ctx
+}
+
+// MatchFile reports whether the file named name in dir satisfies b's
+// build constraints (its //go:build line, its _GOOS/_GOARCH suffix, and
+// any configured BuildTags), the same way `go build` would decide
+// whether to include it.
+func (b BuildContext) MatchFile(dir, name string) (bool, error) {
+	ctx := b.context()
+	return ctx.MatchFile(dir, name)
+}
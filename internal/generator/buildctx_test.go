@@ -0,0 +1,57 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeBuildTestFile(t *testing.T, dir, name, contents string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(contents), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBuildContextMatchesGoBuildLine(t *testing.T) {
+	dir := t.TempDir()
+	writeBuildTestFile(t, dir, "model_extra.go", "//go:build extra\n\npackage models\n")
+
+	var cfg BuildContext
+	if ok, err := cfg.MatchFile(dir, "model_extra.go"); err != nil {
+		t.Fatal(err)
+	} else if ok {
+		t.Error("want model_extra.go excluded without the extra tag")
+	}
+
+	cfg.BuildTags = []string{"extra"}
+	if ok, err := cfg.MatchFile(dir, "model_extra.go"); err != nil {
+		t.Fatal(err)
+	} else if !ok {
+		t.Error("want model_extra.go included once its tag is configured")
+	}
+}
+
+func TestBuildContextMatchesGOOSSuffix(t *testing.T) {
+	dir := t.TempDir()
+	writeBuildTestFile(t, dir, "model_linux.go", "package models\n")
+	writeBuildTestFile(t, dir, "model_windows.go", "package models\n")
+
+	cfg := BuildContext{GOOS: "linux", GOARCH: "amd64"}
+
+	ok, err := cfg.MatchFile(dir, "model_linux.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Error("want model_linux.go included when GOOS is linux")
+	}
+
+	ok, err = cfg.MatchFile(dir, "model_windows.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok {
+		t.Error("want model_windows.go excluded when GOOS is linux")
+	}
+}
@@ -0,0 +1,74 @@
+package generator
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/semver"
+)
+
+// MinGormVersion is the oldest gorm.io/gorm release the code Render
+// produces is guaranteed to compile against. Bump it whenever a
+// generated call site starts relying on a newer gorm API (e.g.
+// clause.Association ops), so CheckGormCompatibility can catch a stale
+// target module at gen time instead of leaving it to a compile error.
+const MinGormVersion = "v1.25.0"
+
+// CheckGormCompatibility reads the gorm.io/gorm requirement from the
+// go.mod nearest to (at or above) dir and fails with a clear message if
+// it's older than MinGormVersion, so `gorm gen` doesn't hand the target
+// module code it can't compile.
+func CheckGormCompatibility(dir string) error {
+	path, err := findGoMod(dir)
+	if err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("generator: reading %s: %w", path, err)
+	}
+	mf, err := modfile.Parse(path, data, nil)
+	if err != nil {
+		return fmt.Errorf("generator: parsing %s: %w", path, err)
+	}
+
+	for _, req := range mf.Require {
+		if req.Mod.Path != "gorm.io/gorm" {
+			continue
+		}
+		if semver.Compare(req.Mod.Version, MinGormVersion) < 0 {
+			return fmt.Errorf(
+				"generator: %s requires gorm.io/gorm %s, but the generated code needs %s or newer; run `go get gorm.io/gorm@latest` in the target module",
+				path, req.Mod.Version, MinGormVersion,
+			)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("generator: %s does not require gorm.io/gorm", path)
+}
+
+// findGoMod walks up from dir looking for the nearest go.mod, the way
+// the go command itself resolves a module root.
+func findGoMod(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+
+	for {
+		candidate := filepath.Join(dir, "go.mod")
+		if _, err := os.Stat(candidate); err == nil {
+			return candidate, nil
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", fmt.Errorf("generator: no go.mod found above %s", dir)
+		}
+		dir = parent
+	}
+}
@@ -0,0 +1,58 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeGoMod(t *testing.T, dir, gormVersion string) {
+	t.Helper()
+	contents := "module compatexample\n\ngo 1.22\n\nrequire gorm.io/gorm " + gormVersion + "\n"
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte(contents), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCheckGormCompatibilityOK(t *testing.T) {
+	dir := t.TempDir()
+	writeGoMod(t, dir, "v1.25.12")
+
+	if err := CheckGormCompatibility(dir); err != nil {
+		t.Errorf("CheckGormCompatibility() = %v, want nil for a recent gorm", err)
+	}
+}
+
+func TestCheckGormCompatibilityTooOld(t *testing.T) {
+	dir := t.TempDir()
+	writeGoMod(t, dir, "v1.20.0")
+
+	err := CheckGormCompatibility(dir)
+	if err == nil {
+		t.Fatal("want an error for a gorm version older than MinGormVersion")
+	}
+}
+
+func TestCheckGormCompatibilityFindsAncestorGoMod(t *testing.T) {
+	root := t.TempDir()
+	writeGoMod(t, root, "v1.25.12")
+	nested := filepath.Join(root, "internal", "query")
+	if err := os.MkdirAll(nested, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := CheckGormCompatibility(nested); err != nil {
+		t.Errorf("CheckGormCompatibility() = %v, want nil when go.mod is found in an ancestor directory", err)
+	}
+}
+
+func TestCheckGormCompatibilityMissingGoMod(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "does-not-exist")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	// No go.mod anywhere above a fresh temp dir (outside any module).
+	if err := CheckGormCompatibility(dir); err == nil {
+		t.Error("want an error when no go.mod is found")
+	}
+}
@@ -0,0 +1,259 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+
+	"github.com/go-gorm/cli/generrors"
+)
+
+var genModeNames = map[GenMode]string{
+	GenModeAll:           "all",
+	GenModeInterfaceOnly: "interfaceOnly",
+	GenModeFieldsOnly:    "fieldsOnly",
+}
+
+// Config holds per-project generation settings read from the project's
+// genconfig.yaml file.
+type Config struct {
+	// ForbidUntypedOrder makes ValidateOrderCall reject calls to the
+	// passthrough Interface[T].Order in favor of the strictly typed
+	// OrderBy, so a project can enforce fully typed ordering everywhere.
+	ForbidUntypedOrder bool `yaml:"forbidUntypedOrder,omitempty"`
+
+	// RequireContext makes ValidateInterfaceContext reject an annotated
+	// query interface method whose first parameter isn't
+	// context.Context, so generated data access stays traceable and
+	// cancellable end to end. A missing context.Context is never
+	// injected automatically - that would silently change the method's
+	// signature - the check instead fails with guidance to add it.
+	RequireContext bool `yaml:"requireContext,omitempty"`
+
+	// OutPath is the directory this genconfig.yaml's settings apply to,
+	// relative to the genconfig.yaml file itself. In a tree with more
+	// than one genconfig.yaml, every OutPath must be distinct;
+	// ValidateConfigs flags collisions before a long generation run.
+	OutPath string `yaml:"outPath,omitempty"`
+
+	// FieldNameMap overrides the Go struct field name gen derives for a
+	// column, keyed by table name then column name, e.g.
+	// {"users": {"id": "ID"}} so an auto-derived "Id" doesn't have to be
+	// fixed up by hand after every regeneration.
+	FieldNameMap map[string]map[string]string `yaml:"fieldNameMap,omitempty"`
+
+	// ColumnNameMap overrides the database column name gen resolves a Go
+	// field to, keyed by table name then field name. Mainly useful for
+	// reserved words or other columns whose derived name would collide.
+	ColumnNameMap map[string]map[string]string `yaml:"columnNameMap,omitempty"`
+
+	// TenantColumns declares the tenant/organization column for
+	// multi-tenant models, keyed by table name, e.g. {"orders":
+	// "tenant_id"}. Pairs with gen.ForTenant to scope a query and
+	// gen.RequireTenantScope to fail any query on the table whose WHERE
+	// clause doesn't reference it. ValidateConfigs flags a
+	// tenantColumns entry naming a table that doesn't exist.
+	TenantColumns map[string]string `yaml:"tenantColumns,omitempty"`
+
+	// EncryptedColumns declares which columns are encrypted at rest,
+	// keyed by table name then column name, with the value naming the
+	// encryptor to use, e.g. {"users": {"ssn": "aes"}}. Generated field
+	// helpers for these columns build field.EncryptedField instead of
+	// the plain typed field, and generated models get the matching
+	// `gorm:"serializer:<name>"` tag; the named encryptor itself is
+	// registered at runtime with gen.RegisterEncryptedSerializer.
+	EncryptedColumns map[string]map[string]string `yaml:"encryptedColumns,omitempty"`
+
+	// AuditColumns declares the created-by/updated-by columns for a
+	// model, keyed by table name, e.g. {"orders": {createdBy:
+	// "created_by", updatedBy: "updated_by"}}. Pairs with
+	// gen.WithAuditColumns, which stamps them from the current
+	// principal on Create/Update.
+	AuditColumns map[string]AuditColumnSet `yaml:"auditColumns,omitempty"`
+
+	// GenerateBenchmarks records a project's opt-in to the Example and
+	// Benchmark stubs RenderBenchmarksWithConfig produces (behind a
+	// gen_bench build tag so they don't run under a plain
+	// `go test ./...`). MergeConfigs carries it through; `gorm gen bench`
+	// does not read it and writes the stubs whenever it is run.
+	GenerateBenchmarks bool `yaml:"generateBenchmarks,omitempty"`
+
+	// GenerateValidation makes RenderModels emit a Validate() method
+	// per model, checking the not-null and size constraints captured
+	// on its columns, and makes generated Create/Update call it via
+	// gen.Validator so a violation surfaces as a plain Go error before
+	// it reaches the database. Check constraints are recorded on the
+	// schema but aren't checked here - validating arbitrary SQL
+	// expressions client-side is out of scope.
+	GenerateValidation bool `yaml:"generateValidation,omitempty"`
+
+	// PackageModes overrides GenMode per generated package (keyed by
+	// package import path), mirroring the CLI's -t flag from source so
+	// the choice travels with the project instead of being a
+	// command-line-only concern.
+	PackageModes map[string]GenMode `yaml:"packageModes,omitempty"`
+
+	// Build selects which //go:build-constrained input files gen scans,
+	// so platform-specific models don't produce duplicate or
+	// conflicting generated symbols. The zero value scans with the
+	// running toolchain's own GOOS/GOARCH and no extra tags.
+	Build BuildContext `yaml:"build,omitempty"`
+
+	// VariableNamePrefix and VariableNameSuffix are added around a
+	// model's name to form its generated per-model variable, e.g.
+	// VariableNameSuffix "Fields" turns "User" into "UserFields", so
+	// the generated identifier doesn't collide when the generated
+	// package is dot-imported or shares a package with the models
+	// themselves.
+	VariableNamePrefix string `yaml:"variableNamePrefix,omitempty"`
+	VariableNameSuffix string `yaml:"variableNameSuffix,omitempty"`
+}
+
+// AuditColumnSet names the created-by and updated-by columns of a
+// model, either of which may be left empty to skip stamping it.
+type AuditColumnSet struct {
+	CreatedBy string `yaml:"createdBy,omitempty"`
+	UpdatedBy string `yaml:"updatedBy,omitempty"`
+}
+
+// HelperIdentifier returns the generated per-model variable name for
+// model, with VariableNamePrefix/VariableNameSuffix applied.
+func (cfg Config) HelperIdentifier(model string) string {
+	return cfg.VariableNamePrefix + model + cfg.VariableNameSuffix
+}
+
+// GenMode selects which parts of gen's generated code a package gets.
+type GenMode int
+
+const (
+	// GenModeAll generates both typed field helpers and the raw-SQL
+	// Interface[T] implementation. This is the default.
+	GenModeAll GenMode = iota
+	// GenModeInterfaceOnly generates only the raw-SQL Interface[T]
+	// implementation, skipping field helpers.
+	GenModeInterfaceOnly
+	// GenModeFieldsOnly generates only typed field helpers, skipping
+	// Interface[T] implementations.
+	GenModeFieldsOnly
+)
+
+// String returns GenMode's genconfig.yaml spelling ("all",
+// "interfaceOnly", "fieldsOnly").
+func (m GenMode) String() string {
+	if name, ok := genModeNames[m]; ok {
+		return name
+	}
+	return fmt.Sprintf("GenMode(%d)", int(m))
+}
+
+// MarshalYAML renders GenMode as its genconfig.yaml spelling rather
+// than the bare underlying int.
+func (m GenMode) MarshalYAML() (interface{}, error) {
+	return m.String(), nil
+}
+
+// UnmarshalYAML parses GenMode from its genconfig.yaml spelling.
+func (m *GenMode) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	for mode, name := range genModeNames {
+		if name == s {
+			*m = mode
+			return nil
+		}
+	}
+	return fmt.Errorf("generator: unknown genMode %q", s)
+}
+
+// ModeFor returns the configured GenMode for pkg, defaulting to
+// GenModeAll when pkg has no override.
+func (cfg Config) ModeFor(pkg string) GenMode {
+	if m, ok := cfg.PackageModes[pkg]; ok {
+		return m
+	}
+	return GenModeAll
+}
+
+// GenerateFieldHelpers reports whether pkg's GenMode includes typed
+// field helpers.
+func (cfg Config) GenerateFieldHelpers(pkg string) bool {
+	return cfg.ModeFor(pkg) != GenModeInterfaceOnly
+}
+
+// GenerateInterfaces reports whether pkg's GenMode includes the raw-SQL
+// Interface[T] implementation.
+func (cfg Config) GenerateInterfaces(pkg string) bool {
+	return cfg.ModeFor(pkg) != GenModeFieldsOnly
+}
+
+// ResolveFieldName returns the configured field name override for
+// table's column, or derived if none is configured.
+func (cfg Config) ResolveFieldName(table, column, derived string) string {
+	if name, ok := cfg.FieldNameMap[table][column]; ok {
+		return name
+	}
+	return derived
+}
+
+// ResolveColumnName returns the configured column name override for
+// table's field, or derived if none is configured.
+func (cfg Config) ResolveColumnName(table, field, derived string) string {
+	if name, ok := cfg.ColumnNameMap[table][field]; ok {
+		return name
+	}
+	return derived
+}
+
+// ValidateOrderCall checks a single call site's method against cfg.
+// method is "Order" or "OrderBy"; it returns an error when cfg forbids
+// the call site's method. `gorm gen` neither discovers call sites nor
+// calls it; it checks only the method name it is given.
+func ValidateOrderCall(cfg Config, method string) error {
+	if cfg.ForbidUntypedOrder && method == "Order" {
+		return &generrors.ErrInvalidAnnotation{Method: method, Reason: "genconfig forbids the untyped Order call; use OrderBy instead"}
+	}
+	return nil
+}
+
+// ValidateInterfaceContext checks iface's methods against cfg's
+// RequireContext setting, returning one error per method whose first
+// parameter isn't context.Context. It never rewrites iface - injecting
+// a parameter behind the author's back would be more surprising than
+// useful - the caller is expected to surface these as guidance and let
+// the author add the parameter themselves.
+func ValidateInterfaceContext(cfg Config, iface *ast.InterfaceType) []error {
+	if !cfg.RequireContext {
+		return nil
+	}
+
+	var errs []error
+	for _, m := range iface.Methods.List {
+		fn, ok := m.Type.(*ast.FuncType)
+		if !ok || len(m.Names) == 0 {
+			continue
+		}
+		name := m.Names[0].Name
+		if !firstParamIsContext(fn) {
+			errs = append(errs, &generrors.ErrInvalidAnnotation{
+				Method: name,
+				Reason: fmt.Sprintf("genconfig requires context.Context as the first parameter of %s; add one, e.g. %s(ctx context.Context, ...)", name, name),
+			})
+		}
+	}
+	return errs
+}
+
+// firstParamIsContext reports whether fn's first parameter has the
+// selector expression context.Context.
+func firstParamIsContext(fn *ast.FuncType) bool {
+	if fn.Params == nil || len(fn.Params.List) == 0 {
+		return false
+	}
+	sel, ok := fn.Params.List[0].Type.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	pkg, ok := sel.X.(*ast.Ident)
+	return ok && pkg.Name == "context" && sel.Sel.Name == "Context"
+}
@@ -0,0 +1,194 @@
+package generator
+
+import (
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/go-gorm/cli/generrors"
+)
+
+// parseInterface parses src as a standalone Go file and returns the
+// *ast.InterfaceType of its first declared interface, for exercising
+// ValidateInterfaceContext without a full package load.
+func parseInterface(t *testing.T, src string) *ast.InterfaceType {
+	t.Helper()
+	file, err := parser.ParseFile(token.NewFileSet(), "query.go", "package query\n\nimport \"context\"\n\n"+src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			ts, ok := spec.(*ast.TypeSpec)
+			if !ok {
+				continue
+			}
+			if iface, ok := ts.Type.(*ast.InterfaceType); ok {
+				return iface
+			}
+		}
+	}
+	t.Fatal("no interface declaration found in src")
+	return nil
+}
+
+func TestValidateOrderCallForbidsUntyped(t *testing.T) {
+	cfg := Config{ForbidUntypedOrder: true}
+	err := ValidateOrderCall(cfg, "Order")
+	if err == nil {
+		t.Fatal("expected error for Order when ForbidUntypedOrder is set")
+	}
+	var annotationErr *generrors.ErrInvalidAnnotation
+	if !errors.As(err, &annotationErr) {
+		t.Fatalf("err = %v, want a *generrors.ErrInvalidAnnotation", err)
+	}
+	if annotationErr.Method != "Order" {
+		t.Errorf("Method = %q, want Order", annotationErr.Method)
+	}
+	if err := ValidateOrderCall(cfg, "OrderBy"); err != nil {
+		t.Errorf("OrderBy should always be allowed, got %v", err)
+	}
+}
+
+func TestValidateOrderCallAllowsUntypedByDefault(t *testing.T) {
+	var cfg Config
+	if err := ValidateOrderCall(cfg, "Order"); err != nil {
+		t.Errorf("Order should be allowed by default, got %v", err)
+	}
+}
+
+func TestValidateInterfaceContextRejectsMissingContext(t *testing.T) {
+	iface := parseInterface(t, `type User interface {
+	GetByID(id int) (*User, error)
+}`)
+
+	errs := ValidateInterfaceContext(Config{RequireContext: true}, iface)
+	if len(errs) != 1 {
+		t.Fatalf("len(errs) = %d, want 1", len(errs))
+	}
+	if !strings.Contains(errs[0].Error(), "GetByID") {
+		t.Errorf("error = %v, want it to name the offending method", errs[0])
+	}
+}
+
+func TestValidateInterfaceContextAllowsContextFirst(t *testing.T) {
+	iface := parseInterface(t, `type User interface {
+	GetByID(ctx context.Context, id int) (*User, error)
+}`)
+
+	if errs := ValidateInterfaceContext(Config{RequireContext: true}, iface); len(errs) != 0 {
+		t.Errorf("errs = %v, want none", errs)
+	}
+}
+
+func TestValidateInterfaceContextDisabledByDefault(t *testing.T) {
+	iface := parseInterface(t, `type User interface {
+	GetByID(id int) (*User, error)
+}`)
+
+	if errs := ValidateInterfaceContext(Config{}, iface); len(errs) != 0 {
+		t.Errorf("errs = %v, want none when RequireContext is unset", errs)
+	}
+}
+
+func TestResolveFieldNameOverride(t *testing.T) {
+	cfg := Config{FieldNameMap: map[string]map[string]string{
+		"users": {"id": "ID"},
+	}}
+
+	if got := cfg.ResolveFieldName("users", "id", "Id"); got != "ID" {
+		t.Errorf("ResolveFieldName = %q, want ID", got)
+	}
+	if got := cfg.ResolveFieldName("users", "name", "Name"); got != "Name" {
+		t.Errorf("ResolveFieldName = %q, want derived name unchanged", got)
+	}
+	if got := cfg.ResolveFieldName("posts", "id", "Id"); got != "Id" {
+		t.Errorf("ResolveFieldName = %q, want derived name for an unconfigured table", got)
+	}
+}
+
+func TestGenModeDefaultsToAll(t *testing.T) {
+	var cfg Config
+	if !cfg.GenerateFieldHelpers("query") || !cfg.GenerateInterfaces("query") {
+		t.Error("want both field helpers and interfaces generated by default")
+	}
+}
+
+func TestGenModeInterfaceOnly(t *testing.T) {
+	cfg := Config{PackageModes: map[string]GenMode{"query": GenModeInterfaceOnly}}
+	if cfg.GenerateFieldHelpers("query") {
+		t.Error("want field helpers skipped for GenModeInterfaceOnly")
+	}
+	if !cfg.GenerateInterfaces("query") {
+		t.Error("want interfaces still generated for GenModeInterfaceOnly")
+	}
+	if !cfg.GenerateFieldHelpers("other") {
+		t.Error("want unconfigured packages unaffected")
+	}
+}
+
+func TestGenModeFieldsOnly(t *testing.T) {
+	cfg := Config{PackageModes: map[string]GenMode{"query": GenModeFieldsOnly}}
+	if !cfg.GenerateFieldHelpers("query") {
+		t.Error("want field helpers still generated for GenModeFieldsOnly")
+	}
+	if cfg.GenerateInterfaces("query") {
+		t.Error("want interfaces skipped for GenModeFieldsOnly")
+	}
+}
+
+func TestHelperIdentifierDefault(t *testing.T) {
+	var cfg Config
+	if got := cfg.HelperIdentifier("User"); got != "User" {
+		t.Errorf("HelperIdentifier = %q, want unchanged by default", got)
+	}
+}
+
+func TestHelperIdentifierPrefixSuffix(t *testing.T) {
+	cfg := Config{VariableNamePrefix: "q", VariableNameSuffix: "Fields"}
+	if got := cfg.HelperIdentifier("User"); got != "qUserFields" {
+		t.Errorf("HelperIdentifier = %q, want qUserFields", got)
+	}
+}
+
+func TestGenModeYAMLRoundTrip(t *testing.T) {
+	cfg := Config{PackageModes: map[string]GenMode{"query": GenModeFieldsOnly}}
+
+	data, err := yaml.Marshal(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), "fieldsOnly") {
+		t.Errorf("marshaled = %q, want the human-readable genMode name", data)
+	}
+
+	var roundTripped Config
+	if err := yaml.Unmarshal(data, &roundTripped); err != nil {
+		t.Fatal(err)
+	}
+	if roundTripped.PackageModes["query"] != GenModeFieldsOnly {
+		t.Errorf("PackageModes = %v, want GenModeFieldsOnly to survive the round trip", roundTripped.PackageModes)
+	}
+}
+
+func TestResolveColumnNameOverride(t *testing.T) {
+	cfg := Config{ColumnNameMap: map[string]map[string]string{
+		"orders": {"Type": "order_type"},
+	}}
+
+	if got := cfg.ResolveColumnName("orders", "Type", "type"); got != "order_type" {
+		t.Errorf("ResolveColumnName = %q, want order_type", got)
+	}
+	if got := cfg.ResolveColumnName("orders", "Name", "name"); got != "name" {
+		t.Errorf("ResolveColumnName = %q, want derived name unchanged", got)
+	}
+}
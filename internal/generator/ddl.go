@@ -0,0 +1,199 @@
+package generator
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var ddlTypeSizePattern = regexp.MustCompile(`\((\d+)`)
+
+var ddlHeaderPattern = regexp.MustCompile(`(?i)create\s+table\s+(?:if\s+not\s+exists\s+)?["'` + "`" + `]?(\w+)["'` + "`" + `]?\s*\(`)
+
+var ddlReferencesPattern = regexp.MustCompile(`(?i)references\s+["'` + "`" + `]?(\w+)["'` + "`" + `]?\s*\(`)
+
+var ddlForeignKeyColumnPattern = regexp.MustCompile(`(?i)foreign\s+key\s*\(\s*["'` + "`" + `]?(\w+)["'` + "`" + `]?\s*\)`)
+
+// ddlCommentPattern matches a column's trailing COMMENT 'text' clause,
+// MySQL's syntax for a column-level comment. The pattern operates on
+// already-decoded Go source text, so a non-ASCII comment - Chinese,
+// Cyrillic, emoji - passes through as whatever UTF-8 bytes sql itself
+// contains; nothing here re-encodes or truncates it.
+var ddlCommentPattern = regexp.MustCompile(`(?is)comment\s+'((?:[^']|'')*)'`)
+
+// ddlPrimaryKeyColumnsPattern extracts the column list of a table-level
+// PRIMARY KEY (col1, col2, ...) constraint, the form a composite
+// primary key is declared in.
+var ddlPrimaryKeyColumnsPattern = regexp.MustCompile(`(?is)primary\s+key\s*\(([^)]*)\)`)
+
+// ParseDDL parses the CREATE TABLE statements in sql, the offline
+// counterpart to loadTable's live PRAGMA introspection, for teams whose
+// schema source of truth is checked-in DDL rather than a reachable
+// database.
+//
+// The parser recognizes plain column definitions, inline REFERENCES
+// clauses, and table-level FOREIGN KEY (...) REFERENCES (...)
+// constraints; it does not attempt to validate the DDL as a SQL engine
+// would.
+func ParseDDL(sql string) ([]Table, error) {
+	var tables []Table
+	rest := sql
+	for {
+		loc := ddlHeaderPattern.FindStringSubmatchIndex(rest)
+		if loc == nil {
+			break
+		}
+		name := rest[loc[2]:loc[3]]
+
+		depth := 1
+		i := loc[1]
+		for ; i < len(rest) && depth > 0; i++ {
+			switch rest[i] {
+			case '(':
+				depth++
+			case ')':
+				depth--
+			}
+		}
+		if depth != 0 {
+			return nil, fmt.Errorf("from-sql: unbalanced parentheses in CREATE TABLE %s", name)
+		}
+
+		table, err := parseTableBody(name, rest[loc[1]:i-1])
+		if err != nil {
+			return nil, err
+		}
+		tables = append(tables, table)
+		rest = rest[i:]
+	}
+
+	if len(tables) == 0 {
+		return nil, fmt.Errorf("from-sql: no CREATE TABLE statements found")
+	}
+	return tables, nil
+}
+
+// parseTableBody parses the comma-separated column and constraint list
+// between a CREATE TABLE statement's outer parentheses.
+func parseTableBody(name, body string) (Table, error) {
+	table := Table{Name: name}
+
+	for _, item := range splitTopLevel(body, ',') {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		upper := strings.ToUpper(item)
+
+		switch {
+		case strings.HasPrefix(upper, "PRIMARY KEY"):
+			if m := ddlPrimaryKeyColumnsPattern.FindStringSubmatch(item); m != nil {
+				table.PrimaryKeyColumns = splitPrimaryKeyColumns(m[1])
+			}
+			continue
+		case strings.HasPrefix(upper, "UNIQUE"),
+			strings.HasPrefix(upper, "CONSTRAINT"), strings.HasPrefix(upper, "CHECK"):
+			continue
+		case strings.HasPrefix(upper, "FOREIGN KEY"):
+			colMatch := ddlForeignKeyColumnPattern.FindStringSubmatch(item)
+			refMatch := ddlReferencesPattern.FindStringSubmatch(item)
+			if colMatch != nil && refMatch != nil {
+				table.ForeignKeys = append(table.ForeignKeys, ForeignKey{Column: colMatch[1], RefTable: refMatch[1]})
+			}
+		default:
+			fields := strings.Fields(item)
+			if len(fields) < 2 {
+				continue
+			}
+			col := Column{
+				Name:     stripQuotes(fields[0]),
+				Type:     fields[1],
+				Nullable: !strings.Contains(upper, "NOT NULL") && !strings.Contains(upper, "PRIMARY KEY"),
+				Size:     typeSize(fields[1]),
+				Comment:  columnComment(item),
+			}
+			table.Columns = append(table.Columns, col)
+
+			if refMatch := ddlReferencesPattern.FindStringSubmatch(item); refMatch != nil {
+				table.ForeignKeys = append(table.ForeignKeys, ForeignKey{Column: col.Name, RefTable: refMatch[1]})
+			}
+			if strings.Contains(upper, "PRIMARY KEY") {
+				table.PrimaryKeyColumns = append(table.PrimaryKeyColumns, col.Name)
+			}
+		}
+	}
+
+	return table, nil
+}
+
+// splitTopLevel splits s on sep, ignoring any sep found inside
+// parentheses, so a column type like "VARCHAR(255)" or a constraint
+// like "REFERENCES users(id)" isn't mistaken for two separate items.
+func splitTopLevel(s string, sep byte) []string {
+	var parts []string
+	depth := 0
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case sep:
+			if depth == 0 {
+				parts = append(parts, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	parts = append(parts, s[start:])
+	return parts
+}
+
+// typeSize extracts a declared SQL type's first parenthesized integer
+// argument, e.g. "VARCHAR(255)" -> 255, or 0 if the type declares none.
+// For a multi-argument type like "DECIMAL(10,2)" this is the
+// precision, not a string length - fine for RenderValidateMethod's
+// text-length checks since only character types carry a single-length
+// argument in practice.
+func typeSize(sqlType string) int {
+	m := ddlTypeSizePattern.FindStringSubmatch(sqlType)
+	if m == nil {
+		return 0
+	}
+	n, err := strconv.Atoi(m[1])
+	if err != nil {
+		return 0
+	}
+	return n
+}
+
+func stripQuotes(s string) string {
+	return strings.Trim(s, `"'`+"`")
+}
+
+// splitPrimaryKeyColumns splits a PRIMARY KEY (...) constraint's column
+// list on commas, trimming quotes and whitespace from each name, so a
+// composite key like "PRIMARY KEY (order_id, line_no)" parses to
+// ["order_id", "line_no"] in declaration order.
+func splitPrimaryKeyColumns(columns string) []string {
+	var names []string
+	for _, c := range strings.Split(columns, ",") {
+		if name := stripQuotes(strings.TrimSpace(c)); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// columnComment extracts a column definition's COMMENT 'text' clause,
+// unescaping SQL's doubled-quote escape (” -> '), or "" if item
+// declares none.
+func columnComment(item string) string {
+	m := ddlCommentPattern.FindStringSubmatch(item)
+	if m == nil {
+		return ""
+	}
+	return strings.ReplaceAll(m[1], "''", "'")
+}
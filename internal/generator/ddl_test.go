@@ -0,0 +1,142 @@
+package generator
+
+import "testing"
+
+func TestParseDDL(t *testing.T) {
+	sql := `
+CREATE TABLE users (
+	id INTEGER NOT NULL PRIMARY KEY,
+	name TEXT NOT NULL,
+	bio TEXT
+);
+
+CREATE TABLE posts (
+	id INTEGER NOT NULL PRIMARY KEY,
+	author_id INTEGER NOT NULL REFERENCES users(id),
+	body TEXT,
+	FOREIGN KEY (author_id) REFERENCES users(id)
+);
+`
+
+	tables, err := ParseDDL(sql)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tables) != 2 {
+		t.Fatalf("len(tables) = %d, want 2", len(tables))
+	}
+
+	users, posts := tables[0], tables[1]
+	if users.Name != "users" || len(users.Columns) != 3 {
+		t.Fatalf("users = %+v", users)
+	}
+	if users.Columns[2].Name != "bio" || !users.Columns[2].Nullable {
+		t.Errorf("users.bio = %+v, want nullable", users.Columns[2])
+	}
+	if users.Columns[1].Nullable {
+		t.Errorf("users.name = %+v, want NOT NULL", users.Columns[1])
+	}
+
+	if posts.Name != "posts" || len(posts.Columns) != 3 {
+		t.Fatalf("posts = %+v", posts)
+	}
+	if len(posts.ForeignKeys) == 0 {
+		t.Fatalf("posts.ForeignKeys is empty, want an author_id -> users reference")
+	}
+	for _, fk := range posts.ForeignKeys {
+		if fk.Column != "author_id" || fk.RefTable != "users" {
+			t.Errorf("foreign key = %+v, want author_id -> users", fk)
+		}
+	}
+}
+
+func TestParseDDLPopulatesSize(t *testing.T) {
+	sql := `
+CREATE TABLE users (
+	id INTEGER NOT NULL PRIMARY KEY,
+	email VARCHAR(255) NOT NULL,
+	bio TEXT
+);
+`
+	tables, err := ParseDDL(sql)
+	if err != nil {
+		t.Fatal(err)
+	}
+	email := tables[0].Columns[1]
+	if email.Name != "email" || email.Size != 255 {
+		t.Errorf("email = %+v, want Size 255", email)
+	}
+	if bio := tables[0].Columns[2]; bio.Size != 0 {
+		t.Errorf("bio.Size = %d, want 0", bio.Size)
+	}
+}
+
+func TestParseDDLNoStatements(t *testing.T) {
+	if _, err := ParseDDL("SELECT 1;"); err == nil {
+		t.Fatal("want an error when no CREATE TABLE statements are present")
+	}
+}
+
+func TestParseDDLPopulatesComment(t *testing.T) {
+	sql := `
+CREATE TABLE users (
+	id INTEGER NOT NULL PRIMARY KEY,
+	balance INTEGER COMMENT '账户余额，以分为单位',
+	bio TEXT COMMENT 'it''s optional'
+);
+`
+	tables, err := ParseDDL(sql)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := tables[0].Columns[1].Comment; got != "账户余额，以分为单位" {
+		t.Errorf("balance.Comment = %q, want the non-ASCII comment unmangled", got)
+	}
+	if got := tables[0].Columns[2].Comment; got != "it's optional" {
+		t.Errorf("bio.Comment = %q, want the '' escape unescaped to '", got)
+	}
+	if got := tables[0].Columns[0].Comment; got != "" {
+		t.Errorf("id.Comment = %q, want empty when no COMMENT clause is present", got)
+	}
+}
+
+func TestParseDDLPopulatesSingleColumnPrimaryKey(t *testing.T) {
+	sql := `
+CREATE TABLE users (
+	id INTEGER NOT NULL PRIMARY KEY,
+	name TEXT NOT NULL
+);
+`
+	tables, err := ParseDDL(sql)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := tables[0].PrimaryKeyColumns; len(got) != 1 || got[0] != "id" {
+		t.Errorf("PrimaryKeyColumns = %v, want [id]", got)
+	}
+}
+
+func TestParseDDLPopulatesCompositePrimaryKey(t *testing.T) {
+	sql := `
+CREATE TABLE order_lines (
+	order_id INTEGER NOT NULL,
+	line_no INTEGER NOT NULL,
+	product TEXT NOT NULL,
+	PRIMARY KEY (order_id, line_no)
+);
+`
+	tables, err := ParseDDL(sql)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"order_id", "line_no"}
+	got := tables[0].PrimaryKeyColumns
+	if len(got) != len(want) {
+		t.Fatalf("PrimaryKeyColumns = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("PrimaryKeyColumns = %v, want %v", got, want)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type dialectTestUser struct {
+	ID   uint
+	Name string
+}
+
+func TestRenderDialectSQLVariesPlaceholdersAndQuoting(t *testing.T) {
+	sql, err := RenderDialectSQL(func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&dialectTestUser{}).Where(clause.Eq{Column: clause.Column{Name: "name"}, Value: "alice"}).Find(&[]dialectTestUser{})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(sql["sqlite"], `"name" = ?`) {
+		t.Errorf("sqlite = %q, want double-quoted identifier and ? placeholder", sql["sqlite"])
+	}
+	if !strings.Contains(sql["mysql"], "`name` = ?") {
+		t.Errorf("mysql = %q, want backtick-quoted identifier and ? placeholder", sql["mysql"])
+	}
+	if !strings.Contains(sql["postgres"], `"name" = $1`) {
+		t.Errorf("postgres = %q, want double-quoted identifier and $1 placeholder", sql["postgres"])
+	}
+}
+
+func TestRenderDialectGolden(t *testing.T) {
+	golden, err := RenderDialectGolden(Table{Name: "users", Columns: []Column{{Name: "id"}, {Name: "name"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(golden["sqlite"], `"id" = ?`) {
+		t.Errorf("sqlite golden = %q, want a lookup by id", golden["sqlite"])
+	}
+	if !strings.Contains(golden["sqlite"], "INSERT") {
+		t.Errorf("sqlite golden = %q, want an insert", golden["sqlite"])
+	}
+	if !strings.Contains(golden["postgres"], "$1") {
+		t.Errorf("postgres golden = %q, want a $1 placeholder", golden["postgres"])
+	}
+}
+
+func TestRenderDialectGoldenRejectsEmptyTable(t *testing.T) {
+	if _, err := RenderDialectGolden(Table{Name: "empty"}); err == nil {
+		t.Fatal("want an error for a table with no columns")
+	}
+}
+
+func TestDialectNamesIsSorted(t *testing.T) {
+	names := DialectNames()
+	if len(names) != 3 {
+		t.Fatalf("names = %v, want 3 dialects", names)
+	}
+	for i := 1; i < len(names); i++ {
+		if names[i-1] >= names[i] {
+			t.Errorf("names = %v, want sorted order", names)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package generator
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var entFieldsMethodPattern = regexp.MustCompile(`func\s*\(\s*(?:\w+\s+)?(\w+)\s*\)\s*Fields\(\)\s*\[\]ent\.Field\s*\{`)
+
+var entFieldCallPattern = regexp.MustCompile(`field\.(\w+)\(\s*"(\w+)"\s*\)`)
+
+// ParseEntSchemas parses the `func (X) Fields() []ent.Field { ... }`
+// methods in src, ent's schema definition format, into Tables whose
+// columns mirror each field.* builder call, so a migration off ent can
+// reuse RenderModels instead of hand-porting every schema.
+func ParseEntSchemas(src string) ([]Table, error) {
+	locs := entFieldsMethodPattern.FindAllStringSubmatchIndex(src, -1)
+	if len(locs) == 0 {
+		return nil, fmt.Errorf("from-ent: no \"func (X) Fields() []ent.Field\" methods found")
+	}
+
+	var tables []Table
+	for _, loc := range locs {
+		schemaName := src[loc[2]:loc[3]]
+
+		depth := 1
+		i := loc[1]
+		for ; i < len(src) && depth > 0; i++ {
+			switch src[i] {
+			case '{':
+				depth++
+			case '}':
+				depth--
+			}
+		}
+		if depth != 0 {
+			return nil, fmt.Errorf("from-ent: unbalanced braces in %s.Fields()", schemaName)
+		}
+		body := src[loc[1] : i-1]
+
+		table := Table{Name: entTableName(schemaName)}
+		calls := entFieldCallPattern.FindAllStringSubmatchIndex(body, -1)
+		for ci, call := range calls {
+			entType := body[call[2]:call[3]]
+			column := body[call[4]:call[5]]
+
+			modifierEnd := len(body)
+			if ci+1 < len(calls) {
+				modifierEnd = calls[ci+1][0]
+			}
+			nullable := strings.Contains(body[call[1]:modifierEnd], ".Optional()")
+
+			table.Columns = append(table.Columns, Column{
+				Name:     column,
+				Type:     entColumnType(entType),
+				Nullable: nullable,
+			})
+		}
+		tables = append(tables, table)
+	}
+	return tables, nil
+}
+
+// entTableName derives a table name from an ent schema type name the
+// way ent itself does: lower-cased and naively pluralized, e.g. "User"
+// -> "users".
+func entTableName(schemaName string) string {
+	lower := strings.ToLower(schemaName)
+	if strings.HasSuffix(lower, "s") {
+		return lower
+	}
+	return lower + "s"
+}
+
+// entColumnType maps an ent field builder (field.String, field.Int, ...)
+// to the raw column type RenderModels' goType/fieldMapping resolve from.
+func entColumnType(entType string) string {
+	switch entType {
+	case "Bool":
+		return "BOOLEAN"
+	case "Int", "Int8", "Int16", "Int32", "Int64", "Uint", "Uint8", "Uint16", "Uint32", "Uint64":
+		return "INTEGER"
+	case "Float32", "Float64":
+		return "REAL"
+	case "Time":
+		return "DATETIME"
+	case "Bytes":
+		return "BLOB"
+	default:
+		return "TEXT"
+	}
+}
@@ -0,0 +1,51 @@
+package generator
+
+import "testing"
+
+const entFixture = `package schema
+
+type User struct {
+	ent.Schema
+}
+
+func (User) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name"),
+		field.Int("age").Optional(),
+		field.Time("created_at"),
+	}
+}
+`
+
+func TestParseEntSchemas(t *testing.T) {
+	tables, err := ParseEntSchemas(entFixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tables) != 1 {
+		t.Fatalf("len(tables) = %d, want 1", len(tables))
+	}
+
+	table := tables[0]
+	if table.Name != "users" {
+		t.Errorf("table.Name = %q, want users", table.Name)
+	}
+	if len(table.Columns) != 3 {
+		t.Fatalf("table.Columns = %+v", table.Columns)
+	}
+	if table.Columns[0].Name != "name" || table.Columns[0].Nullable {
+		t.Errorf("name column = %+v, want non-nullable", table.Columns[0])
+	}
+	if table.Columns[1].Name != "age" || !table.Columns[1].Nullable {
+		t.Errorf("age column = %+v, want nullable", table.Columns[1])
+	}
+	if table.Columns[2].Type != "DATETIME" {
+		t.Errorf("created_at column = %+v, want DATETIME", table.Columns[2])
+	}
+}
+
+func TestParseEntSchemasNoFields(t *testing.T) {
+	if _, err := ParseEntSchemas("package schema\n"); err == nil {
+		t.Fatal("want an error when no Fields() methods are present")
+	}
+}
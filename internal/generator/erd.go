@@ -0,0 +1,115 @@
+package generator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// RenderERD renders tables and their foreign-key associations as an
+// entity-relationship diagram definition in format ("mermaid", "dot",
+// or "dbml"), so schema documentation can be generated in CI alongside
+// the code it describes.
+func RenderERD(tables []Table, format string) (string, error) {
+	sorted := append([]Table(nil), tables...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+
+	switch format {
+	case "mermaid":
+		return renderMermaidERD(sorted), nil
+	case "dot":
+		return renderDotERD(sorted), nil
+	case "dbml":
+		return renderDBMLERD(sorted), nil
+	default:
+		return "", fmt.Errorf("generator: unknown erd format %q, want \"mermaid\", \"dot\", or \"dbml\"", format)
+	}
+}
+
+func renderMermaidERD(tables []Table) string {
+	var b strings.Builder
+	b.Grow(totalColumns(tables) * 32)
+	b.WriteString("erDiagram\n")
+	for _, t := range tables {
+		fmt.Fprintf(&b, "    %s {\n", t.Name)
+		for _, c := range t.Columns {
+			fmt.Fprintf(&b, "        %s %s\n", goType(c.Type), c.Name)
+		}
+		b.WriteString("    }\n")
+	}
+	for _, t := range tables {
+		for _, fk := range t.ForeignKeys {
+			fmt.Fprintf(&b, "    %s }o--|| %s : %s\n", t.Name, fk.RefTable, fk.Column)
+		}
+	}
+	return b.String()
+}
+
+func renderDotERD(tables []Table) string {
+	var b strings.Builder
+	b.Grow(totalColumns(tables) * 16)
+	b.WriteString("digraph erd {\n    rankdir=LR;\n    node [shape=record];\n\n")
+	for _, t := range tables {
+		fmt.Fprintf(&b, "    %s [label=\"%s|", t.Name, t.Name)
+		for _, c := range t.Columns {
+			b.WriteString(c.Name)
+			b.WriteString("\\l")
+		}
+		b.WriteString("\"];\n")
+	}
+	b.WriteString("\n")
+	for _, t := range tables {
+		for _, fk := range t.ForeignKeys {
+			fmt.Fprintf(&b, "    %s -> %s [label=\"%s\"];\n", t.Name, fk.RefTable, fk.Column)
+		}
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+func renderDBMLERD(tables []Table) string {
+	var b strings.Builder
+	b.Grow(totalColumns(tables) * 24)
+	for i, t := range tables {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "Table %s {\n", t.Name)
+		for _, c := range t.Columns {
+			fmt.Fprintf(&b, "  %s %s\n", c.Name, dbmlType(c.Type))
+		}
+		b.WriteString("}\n")
+	}
+
+	var refs []string
+	for _, t := range tables {
+		for _, fk := range t.ForeignKeys {
+			refs = append(refs, fmt.Sprintf("Ref: %s.%s > %s.id", t.Name, fk.Column, fk.RefTable))
+		}
+	}
+	if len(refs) > 0 {
+		b.WriteString("\n")
+		b.WriteString(strings.Join(refs, "\n"))
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// dbmlType maps a raw SQL column type to the type token DBML's own
+// grammar expects.
+func dbmlType(sqlType string) string {
+	switch goType(sqlType) {
+	case "int64":
+		return "integer"
+	case "float64":
+		return "float"
+	case "bool":
+		return "boolean"
+	case "time.Time":
+		return "timestamp"
+	case "[]byte":
+		return "blob"
+	default:
+		return "varchar"
+	}
+}
@@ -0,0 +1,67 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func erdFixtureTables() []Table {
+	return []Table{
+		{
+			Name:    "posts",
+			Columns: []Column{{Name: "id", Type: "INTEGER"}, {Name: "author_id", Type: "INTEGER"}},
+			ForeignKeys: []ForeignKey{
+				{Column: "author_id", RefTable: "users"},
+			},
+		},
+		{
+			Name:    "users",
+			Columns: []Column{{Name: "id", Type: "INTEGER"}, {Name: "name", Type: "TEXT"}},
+		},
+	}
+}
+
+func TestRenderERDMermaid(t *testing.T) {
+	out, err := RenderERD(erdFixtureTables(), "mermaid")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(out, "erDiagram\n") {
+		t.Errorf("out = %q, want it to start with erDiagram", out)
+	}
+	if !strings.Contains(out, "posts }o--|| users : author_id") {
+		t.Errorf("out = %q, want a posts -> users relation", out)
+	}
+}
+
+func TestRenderERDDot(t *testing.T) {
+	out, err := RenderERD(erdFixtureTables(), "dot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "digraph erd {") {
+		t.Errorf("out = %q, want a digraph header", out)
+	}
+	if !strings.Contains(out, `posts -> users [label="author_id"];`) {
+		t.Errorf("out = %q, want a posts -> users edge", out)
+	}
+}
+
+func TestRenderERDDBML(t *testing.T) {
+	out, err := RenderERD(erdFixtureTables(), "dbml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "Table users {") {
+		t.Errorf("out = %q, want a users table", out)
+	}
+	if !strings.Contains(out, "Ref: posts.author_id > users.id") {
+		t.Errorf("out = %q, want a posts.author_id ref", out)
+	}
+}
+
+func TestRenderERDUnknownFormat(t *testing.T) {
+	if _, err := RenderERD(erdFixtureTables(), "svg"); err == nil {
+		t.Fatal("want an error for an unknown format")
+	}
+}
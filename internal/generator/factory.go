@@ -0,0 +1,81 @@
+package generator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// RenderFactories renders tables as a NewX(overrides ...func(*X)) *X
+// test factory per model, plus a CreateX helper that persists it
+// through the generics API, so hand-written test fixtures don't drift
+// out of sync with the schema they build rows for.
+func RenderFactories(tables []Table) string {
+	sorted := append([]Table(nil), tables...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+
+	needsTime := false
+	for _, t := range sorted {
+		for _, c := range t.Columns {
+			if goType(c.Type) == "time.Time" {
+				needsTime = true
+			}
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString("// Code generated by gorm gen. DO NOT EDIT.\n\npackage model\n\nimport (\n\t\"context\"\n")
+	if needsTime {
+		b.WriteString("\t\"time\"\n")
+	}
+	b.WriteString("\n\t\"github.com/go-gorm/cli/gen\"\n)\n")
+
+	for _, t := range sorted {
+		name := structName(t.Name)
+
+		fmt.Fprintf(&b, "\n// New%s builds a %s with sensible defaults for every column, applying\n// overrides in order. It does not persist the result; use Create%s for that.\n", name, name, name)
+		fmt.Fprintf(&b, "func New%s(overrides ...func(*%s)) *%s {\n\tm := &%s{\n", name, name, name, name)
+		for _, c := range t.Columns {
+			fmt.Fprintf(&b, "\t\t%s: %s,\n", goFieldName(c.Name), factoryDefault(c))
+		}
+		b.WriteString("\t}\n\tfor _, override := range overrides {\n\t\toverride(m)\n\t}\n\treturn m\n}\n")
+
+		fmt.Fprintf(&b, "\n// Create%s builds a %s with New%s and persists it through q.\n", name, name, name)
+		fmt.Fprintf(&b, "func Create%s(ctx context.Context, q gen.Interface[%s], overrides ...func(*%s)) (*%s, error) {\n", name, name, name, name)
+		fmt.Fprintf(&b, "\tm := New%s(overrides...)\n\tif err := q.WithContext(ctx).Create(m); err != nil {\n\t\treturn nil, err\n\t}\n\treturn m, nil\n}\n", name)
+	}
+
+	return b.String()
+}
+
+// factoryDefault renders the Go literal expression New<Model> uses for
+// c's zero-value default: a value satisfying c's not-null/size
+// constraints rather than the type's plain zero value, so a factory's
+// output passes a generated Validate() method (see RenderValidateMethod)
+// without every caller having to override it.
+func factoryDefault(c Column) string {
+	switch goType(c.Type) {
+	case "bool":
+		return "false"
+	case "int64":
+		return "1"
+	case "float64":
+		return "1"
+	case "time.Time":
+		return "time.Now()"
+	case "[]byte":
+		if c.Nullable {
+			return "nil"
+		}
+		return fmt.Sprintf("[]byte(%q)", c.Name)
+	default:
+		if c.Nullable {
+			return `""`
+		}
+		value := c.Name
+		if c.Size > 0 && len(value) > c.Size {
+			value = value[:c.Size]
+		}
+		return fmt.Sprintf("%q", value)
+	}
+}
@@ -0,0 +1,44 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderFactories(t *testing.T) {
+	tables := []Table{
+		{
+			Name: "users",
+			Columns: []Column{
+				{Name: "id", Type: "INTEGER", Nullable: false},
+				{Name: "name", Type: "VARCHAR(3)", Nullable: false, Size: 3},
+				{Name: "nickname", Type: "TEXT", Nullable: true},
+				{Name: "verified", Type: "BOOLEAN", Nullable: false},
+			},
+		},
+	}
+
+	code := RenderFactories(tables)
+	if !strings.Contains(code, "func NewUsers(overrides ...func(*Users)) *Users {") {
+		t.Fatalf("code = %q, want a NewUsers factory", code)
+	}
+	if !strings.Contains(code, `Name: "nam",`) {
+		t.Errorf("code = %q, want Name truncated to its 3-byte size", code)
+	}
+	if !strings.Contains(code, `Nickname: "",`) {
+		t.Errorf("code = %q, want a nullable Nickname defaulted to empty", code)
+	}
+	if !strings.Contains(code, "Verified: false,") {
+		t.Errorf("code = %q, want Verified defaulted to false", code)
+	}
+	if !strings.Contains(code, "func CreateUsers(ctx context.Context, q gen.Interface[Users], overrides ...func(*Users)) (*Users, error) {") {
+		t.Errorf("code = %q, want a CreateUsers persistence helper", code)
+	}
+}
+
+func TestFactoryDefaultRespectsSize(t *testing.T) {
+	got := factoryDefault(Column{Name: "description", Type: "TEXT", Nullable: false})
+	if got != `"description"` {
+		t.Errorf("factoryDefault = %q, want the column name as a literal", got)
+	}
+}
@@ -0,0 +1,74 @@
+package generator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// BuildGraphQLSchema renders tables as GraphQL SDL, one type per table,
+// to bootstrap a GraphQL API over an existing schema. Foreign keys grow
+// an extra nested relation field alongside their raw scalar column,
+// e.g. a posts.author_id foreign key to users also gets "author: Users".
+func BuildGraphQLSchema(tables []Table) string {
+	sorted := append([]Table(nil), tables...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+
+	var b strings.Builder
+	b.Grow(totalColumns(sorted) * 32)
+	b.WriteString("# Code generated by gorm gen. DO NOT EDIT.\n")
+	for _, t := range sorted {
+		fmt.Fprintf(&b, "\ntype %s {\n", structName(t.Name))
+		for _, c := range t.Columns {
+			fmt.Fprintf(&b, "  %s: %s\n", graphQLFieldName(c.Name), graphQLType(c.Type, c.Nullable))
+		}
+		for _, fk := range t.ForeignKeys {
+			fmt.Fprintf(&b, "  %s: %s\n", graphQLRelationName(fk.Column), structName(fk.RefTable))
+		}
+		b.WriteString("}\n")
+	}
+	return b.String()
+}
+
+// graphQLFieldName converts a snake_case column name to the lowerCamelCase
+// field name GraphQL convention expects.
+func graphQLFieldName(column string) string {
+	parts := strings.Split(column, "_")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] == "" {
+			continue
+		}
+		parts[i] = capitalizeFirst(parts[i])
+	}
+	return strings.Join(parts, "")
+}
+
+// graphQLRelationName derives a relation field's name from its foreign
+// key column by dropping a trailing "_id", e.g. "author_id" -> "author".
+func graphQLRelationName(column string) string {
+	return graphQLFieldName(strings.TrimSuffix(column, "_id"))
+}
+
+// graphQLType maps a raw SQL column type to a GraphQL scalar,
+// appending "!" when the column is NOT NULL.
+func graphQLType(sqlType string, nullable bool) string {
+	scalar := graphQLScalar(sqlType)
+	if nullable {
+		return scalar
+	}
+	return scalar + "!"
+}
+
+func graphQLScalar(sqlType string) string {
+	sqlType = strings.ToUpper(sqlType)
+	switch {
+	case strings.Contains(sqlType, "INT"):
+		return "Int"
+	case strings.Contains(sqlType, "BOOL"):
+		return "Boolean"
+	case strings.Contains(sqlType, "REAL"), strings.Contains(sqlType, "FLOA"), strings.Contains(sqlType, "DOUB"), strings.Contains(sqlType, "DECIMAL"), strings.Contains(sqlType, "NUMERIC"):
+		return "Float"
+	default:
+		return "String"
+	}
+}
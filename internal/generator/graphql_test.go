@@ -0,0 +1,52 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBuildGraphQLSchema(t *testing.T) {
+	tables := []Table{
+		{
+			Name: "posts",
+			Columns: []Column{
+				{Name: "id", Type: "INTEGER", Nullable: false},
+				{Name: "author_id", Type: "INTEGER", Nullable: false},
+				{Name: "body", Type: "TEXT", Nullable: true},
+			},
+			ForeignKeys: []ForeignKey{{Column: "author_id", RefTable: "users"}},
+		},
+	}
+
+	schema := BuildGraphQLSchema(tables)
+	if !strings.Contains(schema, "type Posts {") {
+		t.Errorf("schema = %q, want a Posts type", schema)
+	}
+	if !strings.Contains(schema, "id: Int!") {
+		t.Errorf("schema = %q, want a required id field", schema)
+	}
+	if !strings.Contains(schema, "body: String\n") {
+		t.Errorf("schema = %q, want a nullable body field", schema)
+	}
+	if !strings.Contains(schema, "authorId: Int!") {
+		t.Errorf("schema = %q, want the camelCased authorId scalar field", schema)
+	}
+	if !strings.Contains(schema, "author: Users") {
+		t.Errorf("schema = %q, want a nested author relation field", schema)
+	}
+}
+
+func TestGraphQLFieldName(t *testing.T) {
+	if got := graphQLFieldName("author_id"); got != "authorId" {
+		t.Errorf("graphQLFieldName = %q, want authorId", got)
+	}
+	if got := graphQLFieldName("name"); got != "name" {
+		t.Errorf("graphQLFieldName = %q, want name", got)
+	}
+}
+
+func TestGraphQLRelationName(t *testing.T) {
+	if got := graphQLRelationName("author_id"); got != "author" {
+		t.Errorf("graphQLRelationName = %q, want author", got)
+	}
+}
@@ -0,0 +1,32 @@
+package generator
+
+import (
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// capitalizeFirst normalizes s to NFC and upper-cases its first rune,
+// returning it joined with the rest of s unchanged.
+//
+// Normalizing first matters for an accented identifier: some drivers
+// and catalogs report "é" as the precomposed rune U+00E9, others as
+// "e" followed by a combining acute accent (U+0065 U+0301) - visually
+// identical but a different rune sequence, so an unnormalized ToUpper
+// only capitalizes the bare "e" and leaves the accent dangling in
+// place. NFC folds both forms to the same precomposed rune first.
+//
+// The rune slice below matters too: a byte slice like s[:1] splits a
+// multi-byte UTF-8 leading character (a Chinese column name, "é"
+// itself) in half, producing invalid UTF-8 in the generated
+// identifier. Go identifiers accept any Unicode letter, so this is the
+// only correction needed for non-ASCII table/column names to
+// round-trip into valid, correctly cased generated code.
+func capitalizeFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	r := []rune(norm.NFC.String(s))
+	r[0] = unicode.ToUpper(r[0])
+	return string(r)
+}
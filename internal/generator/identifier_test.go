@@ -0,0 +1,36 @@
+package generator
+
+import "testing"
+
+func TestCapitalizeFirstASCII(t *testing.T) {
+	if got := capitalizeFirst("users"); got != "Users" {
+		t.Errorf("capitalizeFirst(%q) = %q, want Users", "users", got)
+	}
+}
+
+func TestCapitalizeFirstMultiByteLeadingRune(t *testing.T) {
+	if got := capitalizeFirst("咖啡"); got != "咖啡" {
+		t.Errorf("capitalizeFirst(%q) = %q, want the string unchanged - Chinese has no case, but the leading rune must survive intact", "咖啡", got)
+	}
+}
+
+func TestCapitalizeFirstAccentedLetter(t *testing.T) {
+	if got := capitalizeFirst("épices"); got != "Épices" {
+		t.Errorf("capitalizeFirst(%q) = %q, want Épices", "épices", got)
+	}
+}
+
+func TestCapitalizeFirstNormalizesDecomposedAccent(t *testing.T) {
+	decomposed := "épicerie" // "e" + combining acute accent
+	got := capitalizeFirst(decomposed)
+	want := "Épicerie"
+	if got != want {
+		t.Errorf("capitalizeFirst(decomposed é) = %q, want %q (NFC-normalized and capitalized)", got, want)
+	}
+}
+
+func TestCapitalizeFirstEmpty(t *testing.T) {
+	if got := capitalizeFirst(""); got != "" {
+		t.Errorf("capitalizeFirst(\"\") = %q, want empty", got)
+	}
+}
@@ -0,0 +1,114 @@
+package generator
+
+import (
+	"fmt"
+	"go/token"
+	"strings"
+)
+
+// reservedPackageNames are the packages RenderWithConfig and
+// RenderModelsWithConfig may need to import into the file an
+// identifier is declared in. A table or column whose derived
+// identifier matches one of these either shadows the import for the
+// rest of the file or, for HelperIdentifier's un-capitalized accessor
+// variable, redeclares the package name outright.
+var reservedPackageNames = map[string]bool{
+	"gen":   true, // gorm.io/gen, imported by Render/RenderWithConfig
+	"field": true, // github.com/go-gorm/cli/field, imported by RenderModels
+	"time":  true, // imported by RenderModels when a column needs time.Time
+	"fmt":   true, // imported by RenderModels when GenerateValidation is set
+}
+
+// IdentifierCollision reports one problem CheckIdentifiers found: an
+// identifier tables would derive that can't be declared, or that
+// collides with another one tables would also derive.
+type IdentifierCollision struct {
+	Table      string
+	Identifier string
+	Reason     string
+}
+
+// CheckIdentifiers validates the accessor variable (HelperIdentifier),
+// struct type (structName), and field names (goFieldName)
+// RenderWithConfig/RenderModelsWithConfig would derive for tables
+// against cfg, flagging any that:
+//   - are a Go keyword, so the identifier can't be declared at all
+//     (e.g. a table literally named "type" or "range"),
+//   - collide with a package the generated code may need to import, or
+//   - collide with another table's or column's derived identifier.
+//
+// CheckIdentifiers doesn't rename anything itself - cfg's
+// VariableNamePrefix and VariableNameSuffix are the project's existing,
+// configurable escape hatch for disambiguating HelperIdentifier's
+// accessor variable. CheckIdentifiers's job is to catch a collision
+// before `gorm gen` writes code that doesn't compile, not to guess a
+// fix on the project's behalf.
+func CheckIdentifiers(cfg Config, tables []Table) []IdentifierCollision {
+	var collisions []IdentifierCollision
+	seenAccessors := map[string]string{}
+	seenStructs := map[string]string{}
+
+	for _, t := range tables {
+		accessor := cfg.HelperIdentifier(t.Name)
+		switch {
+		case token.IsKeyword(accessor):
+			collisions = append(collisions, IdentifierCollision{
+				Table: t.Name, Identifier: accessor,
+				Reason: "accessor variable is the Go keyword " + accessor + "; set variableNamePrefix or variableNameSuffix in genconfig.yaml",
+			})
+		case reservedPackageNames[accessor]:
+			collisions = append(collisions, IdentifierCollision{
+				Table: t.Name, Identifier: accessor,
+				Reason: "accessor variable shadows the " + accessor + " package import; set variableNamePrefix or variableNameSuffix",
+			})
+		default:
+			if other, ok := seenAccessors[accessor]; ok {
+				collisions = append(collisions, IdentifierCollision{
+					Table: t.Name, Identifier: accessor,
+					Reason: "accessor variable collides with table " + other + "'s; set variableNamePrefix or variableNameSuffix",
+				})
+			}
+		}
+		seenAccessors[accessor] = t.Name
+
+		name := structName(t.Name)
+		if other, ok := seenStructs[name]; ok {
+			collisions = append(collisions, IdentifierCollision{
+				Table: t.Name, Identifier: name,
+				Reason: "struct type collides with table " + other + "'s",
+			})
+		}
+		seenStructs[name] = t.Name
+
+		seenFields := map[string]string{}
+		for _, c := range t.Columns {
+			field := goFieldName(c.Name)
+			if other, ok := seenFields[field]; ok {
+				collisions = append(collisions, IdentifierCollision{
+					Table: t.Name, Identifier: field,
+					Reason: "field collides with column " + other + "'s on the same table",
+				})
+			}
+			seenFields[field] = c.Name
+		}
+	}
+
+	return collisions
+}
+
+// Error formats a single collision as "table: reason"; CollisionError
+// joins several of these, one per line.
+func (c IdentifierCollision) Error() string {
+	return fmt.Sprintf("%s: %s", c.Table, c.Reason)
+}
+
+// CollisionError joins collisions (which must be non-empty) into a
+// single error, for a caller that wants to reject generation as soon
+// as CheckIdentifiers finds anything.
+func CollisionError(collisions []IdentifierCollision) error {
+	lines := make([]string, len(collisions))
+	for i, c := range collisions {
+		lines[i] = c.Error()
+	}
+	return fmt.Errorf("identifier collisions found:\n%s", strings.Join(lines, "\n"))
+}
@@ -0,0 +1,79 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheckIdentifiersFlagsKeywordAccessor(t *testing.T) {
+	tables := []Table{{Name: "range", Columns: []Column{{Name: "id"}}}}
+
+	collisions := CheckIdentifiers(Config{}, tables)
+	if len(collisions) != 1 {
+		t.Fatalf("CheckIdentifiers = %v, want exactly one collision", collisions)
+	}
+	if !strings.Contains(collisions[0].Reason, "keyword") {
+		t.Errorf("collisions[0].Reason = %q, want it to mention the keyword", collisions[0].Reason)
+	}
+}
+
+func TestCheckIdentifiersFlagsReservedPackageName(t *testing.T) {
+	tables := []Table{{Name: "field", Columns: []Column{{Name: "id"}}}}
+
+	collisions := CheckIdentifiers(Config{}, tables)
+	if len(collisions) != 1 {
+		t.Fatalf("CheckIdentifiers = %v, want exactly one collision", collisions)
+	}
+	if !strings.Contains(collisions[0].Reason, "package") {
+		t.Errorf("collisions[0].Reason = %q, want it to mention the package", collisions[0].Reason)
+	}
+}
+
+func TestCheckIdentifiersFlagsDuplicateStructTypes(t *testing.T) {
+	tables := []Table{
+		{Name: "users", Columns: []Column{{Name: "id"}}},
+		{Name: "Users", Columns: []Column{{Name: "id"}}},
+	}
+
+	collisions := CheckIdentifiers(Config{}, tables)
+	if len(collisions) != 1 {
+		t.Fatalf("CheckIdentifiers = %v, want exactly one collision", collisions)
+	}
+	if !strings.Contains(collisions[0].Reason, "collides with table") {
+		t.Errorf("collisions[0].Reason = %q, want it to report the struct type collision", collisions[0].Reason)
+	}
+}
+
+func TestCheckIdentifiersFlagsDuplicateFields(t *testing.T) {
+	tables := []Table{
+		{Name: "users", Columns: []Column{{Name: "author_id"}, {Name: "Author_Id"}}},
+	}
+
+	collisions := CheckIdentifiers(Config{}, tables)
+	if len(collisions) != 1 {
+		t.Fatalf("CheckIdentifiers = %v, want exactly one collision", collisions)
+	}
+	if !strings.Contains(collisions[0].Reason, "field collides") {
+		t.Errorf("collisions[0].Reason = %q, want it to report the field collision", collisions[0].Reason)
+	}
+}
+
+func TestCheckIdentifiersRespectsConfiguredPrefix(t *testing.T) {
+	tables := []Table{{Name: "range", Columns: []Column{{Name: "id"}}}}
+
+	collisions := CheckIdentifiers(Config{VariableNamePrefix: "T"}, tables)
+	if len(collisions) != 0 {
+		t.Errorf("CheckIdentifiers with a configured prefix = %v, want no collisions", collisions)
+	}
+}
+
+func TestCheckIdentifiersNoCollisions(t *testing.T) {
+	tables := []Table{
+		{Name: "users", Columns: []Column{{Name: "id"}, {Name: "name"}}},
+		{Name: "posts", Columns: []Column{{Name: "id"}, {Name: "author_id"}}},
+	}
+
+	if collisions := CheckIdentifiers(Config{}, tables); len(collisions) != 0 {
+		t.Errorf("CheckIdentifiers = %v, want no collisions", collisions)
+	}
+}
@@ -0,0 +1,80 @@
+package generator
+
+import (
+	"sort"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+)
+
+// ListTables returns the table names visible in the sqlite database at
+// dsn, ordered by name. It's the same catalog query `gorm gen` falls
+// back to when no table names are given on the command line, and what
+// backs shell completion for table-name arguments.
+func ListTables(dsn string) ([]string, error) {
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{})
+	if err != nil {
+		return nil, err
+	}
+
+	var tables []string
+	err = db.Raw("SELECT name FROM sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%' ORDER BY name").Scan(&tables).Error
+	return tables, err
+}
+
+// LoadTable introspects table's columns (name, declared SQL type,
+// nullability) and foreign keys via PRAGMA table_info/foreign_key_list,
+// the same catalog gen itself reads from to decide each column's
+// generated Go type.
+func LoadTable(dsn, table string) (Table, error) {
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{})
+	if err != nil {
+		return Table{}, err
+	}
+
+	var rows []struct {
+		Name    string `gorm:"column:name"`
+		Type    string `gorm:"column:type"`
+		NotNull bool   `gorm:"column:notnull"`
+		PK      int    `gorm:"column:pk"`
+	}
+	if err := db.Raw("SELECT name, type, \"notnull\", pk FROM pragma_table_info(?)", table).Scan(&rows).Error; err != nil {
+		return Table{}, err
+	}
+
+	t := Table{Name: table}
+	var pkColumns []struct {
+		name string
+		pos  int
+	}
+	for _, r := range rows {
+		t.Columns = append(t.Columns, Column{
+			Name:     r.Name,
+			Type:     r.Type,
+			Nullable: !r.NotNull,
+		})
+		if r.PK > 0 {
+			pkColumns = append(pkColumns, struct {
+				name string
+				pos  int
+			}{r.Name, r.PK})
+		}
+	}
+	sort.Slice(pkColumns, func(i, j int) bool { return pkColumns[i].pos < pkColumns[j].pos })
+	for _, pk := range pkColumns {
+		t.PrimaryKeyColumns = append(t.PrimaryKeyColumns, pk.name)
+	}
+
+	var fks []struct {
+		From string `gorm:"column:from"`
+		To   string `gorm:"column:table"`
+	}
+	if err := db.Raw("SELECT \"from\", \"table\" FROM pragma_foreign_key_list(?)", table).Scan(&fks).Error; err != nil {
+		return Table{}, err
+	}
+	for _, fk := range fks {
+		t.ForeignKeys = append(t.ForeignKeys, ForeignKey{Column: fk.From, RefTable: fk.To})
+	}
+
+	return t, nil
+}
@@ -0,0 +1,77 @@
+package generator
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+)
+
+func newTestSQLiteFile(t *testing.T, schema string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "test.db")
+	db, err := gorm.Open(sqlite.Open(path), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	if err := db.Exec(schema).Error; err != nil {
+		t.Fatalf("exec schema: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("underlying sql.DB: %v", err)
+	}
+	if err := sqlDB.Close(); err != nil {
+		t.Fatalf("close sqlite: %v", err)
+	}
+	return path
+}
+
+func TestLoadTablePopulatesSingleColumnPrimaryKey(t *testing.T) {
+	dsn := newTestSQLiteFile(t, `CREATE TABLE users (id INTEGER NOT NULL PRIMARY KEY, name TEXT NOT NULL)`)
+
+	table, err := LoadTable(dsn, "users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := table.PrimaryKeyColumns; len(got) != 1 || got[0] != "id" {
+		t.Errorf("PrimaryKeyColumns = %v, want [id]", got)
+	}
+}
+
+func TestLoadTablePopulatesCompositePrimaryKeyInKeyOrder(t *testing.T) {
+	dsn := newTestSQLiteFile(t, `CREATE TABLE order_lines (
+		line_no INTEGER NOT NULL,
+		order_id INTEGER NOT NULL,
+		product TEXT NOT NULL,
+		PRIMARY KEY (order_id, line_no)
+	)`)
+
+	table, err := LoadTable(dsn, "order_lines")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"order_id", "line_no"}
+	got := table.PrimaryKeyColumns
+	if len(got) != len(want) {
+		t.Fatalf("PrimaryKeyColumns = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("PrimaryKeyColumns = %v, want %v (declaration order in the PK clause, not column order)", got, want)
+		}
+	}
+}
+
+func TestLoadTableNoPrimaryKey(t *testing.T) {
+	dsn := newTestSQLiteFile(t, `CREATE TABLE events (payload TEXT)`)
+
+	table, err := LoadTable(dsn, "events")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(table.PrimaryKeyColumns) != 0 {
+		t.Errorf("PrimaryKeyColumns = %v, want empty", table.PrimaryKeyColumns)
+	}
+}
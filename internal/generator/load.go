@@ -0,0 +1,243 @@
+package generator
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// configFileName is the genconfig file FindConfigs looks for in a tree.
+const configFileName = "genconfig.yaml"
+
+// LoadConfig reads and parses a single genconfig.yaml file.
+func LoadConfig(path string) (Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, err
+	}
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return Config{}, fmt.Errorf("generator: parsing %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// LoadConfigFile parses a single genconfig.yaml file, returning one
+// Config per path it applies to. A flat file (the original shape, with
+// Config's own fields at the top level) yields a single entry keyed by
+// the file's own path. A file with a top-level `packages:` section
+// yields one entry per key, each resolved relative to the file's
+// directory, so a monorepo can keep every per-package section in one
+// root genconfig.yaml instead of scattering a file per package.
+func LoadConfigFile(path string) (map[string]Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var sectioned struct {
+		Packages map[string]Config `yaml:"packages"`
+	}
+	if err := yaml.Unmarshal(data, &sectioned); err != nil {
+		return nil, fmt.Errorf("generator: parsing %s: %w", path, err)
+	}
+	if len(sectioned.Packages) > 0 {
+		out := make(map[string]Config, len(sectioned.Packages))
+		for rel, cfg := range sectioned.Packages {
+			out[filepath.Join(filepath.Dir(path), rel)] = cfg
+		}
+		return out, nil
+	}
+
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("generator: parsing %s: %w", path, err)
+	}
+	return map[string]Config{path: cfg}, nil
+}
+
+// FindConfigs walks root for every genconfig.yaml in the tree, so a
+// monorepo can keep one alongside each generated package instead of a
+// single project-wide file. The result is keyed by each package's
+// resolved path (see LoadConfigFile for how a `packages:` section
+// expands into more than one entry per file).
+func FindConfigs(root string) (map[string]Config, error) {
+	found := map[string]Config{}
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || d.Name() != configFileName {
+			return nil
+		}
+		entries, err := LoadConfigFile(path)
+		if err != nil {
+			return err
+		}
+		for p, cfg := range entries {
+			found[p] = cfg
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return found, nil
+}
+
+// MergeConfigs combines every genconfig.yaml found across a tree into
+// the single effective Config `gorm gen` would apply, for `gorm gen
+// config validate` to print. Files are merged in path order; later
+// files win on a scalar field conflict, and map fields are overlaid
+// rather than replaced.
+func MergeConfigs(configs map[string]Config) Config {
+	paths := make([]string, 0, len(configs))
+	for p := range configs {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	var merged Config
+	for _, p := range paths {
+		cfg := configs[p]
+
+		if cfg.ForbidUntypedOrder {
+			merged.ForbidUntypedOrder = true
+		}
+		if cfg.RequireContext {
+			merged.RequireContext = true
+		}
+		if cfg.GenerateValidation {
+			merged.GenerateValidation = true
+		}
+		if cfg.GenerateBenchmarks {
+			merged.GenerateBenchmarks = true
+		}
+		if cfg.VariableNamePrefix != "" {
+			merged.VariableNamePrefix = cfg.VariableNamePrefix
+		}
+		if cfg.VariableNameSuffix != "" {
+			merged.VariableNameSuffix = cfg.VariableNameSuffix
+		}
+		if cfg.Build.GOOS != "" {
+			merged.Build.GOOS = cfg.Build.GOOS
+		}
+		if cfg.Build.GOARCH != "" {
+			merged.Build.GOARCH = cfg.Build.GOARCH
+		}
+		if len(cfg.Build.BuildTags) > 0 {
+			merged.Build.BuildTags = cfg.Build.BuildTags
+		}
+
+		for table, cols := range cfg.FieldNameMap {
+			mergeNestedStringMap(&merged.FieldNameMap, table, cols)
+		}
+		for table, cols := range cfg.ColumnNameMap {
+			mergeNestedStringMap(&merged.ColumnNameMap, table, cols)
+		}
+		for table, cols := range cfg.EncryptedColumns {
+			mergeNestedStringMap(&merged.EncryptedColumns, table, cols)
+		}
+		for table, column := range cfg.TenantColumns {
+			if merged.TenantColumns == nil {
+				merged.TenantColumns = map[string]string{}
+			}
+			merged.TenantColumns[table] = column
+		}
+		for table, columns := range cfg.AuditColumns {
+			if merged.AuditColumns == nil {
+				merged.AuditColumns = map[string]AuditColumnSet{}
+			}
+			merged.AuditColumns[table] = columns
+		}
+		for pkg, mode := range cfg.PackageModes {
+			if merged.PackageModes == nil {
+				merged.PackageModes = map[string]GenMode{}
+			}
+			merged.PackageModes[pkg] = mode
+		}
+	}
+	return merged
+}
+
+func mergeNestedStringMap(dst *map[string]map[string]string, key string, values map[string]string) {
+	if *dst == nil {
+		*dst = map[string]map[string]string{}
+	}
+	if (*dst)[key] == nil {
+		(*dst)[key] = map[string]string{}
+	}
+	for k, v := range values {
+		(*dst)[key][k] = v
+	}
+}
+
+// ValidateConfigs checks the genconfig.yaml files found across a tree
+// (keyed by path, as returned by FindConfigs) for problems that would
+// otherwise surface mid-generation: two files resolving OutPath to the
+// same directory, and FieldNameMap/ColumnNameMap entries naming a table
+// that doesn't exist. tables may be nil to skip the table-existence
+// check (e.g. when run without a --dsn to check against).
+func ValidateConfigs(configs map[string]Config, tables []string) []string {
+	known := make(map[string]bool, len(tables))
+	for _, t := range tables {
+		known[t] = true
+	}
+
+	paths := make([]string, 0, len(configs))
+	for p := range configs {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	var problems []string
+	outPaths := map[string]string{}
+	for _, path := range paths {
+		cfg := configs[path]
+
+		if cfg.OutPath != "" {
+			abs := filepath.Join(filepath.Dir(path), cfg.OutPath)
+			if prior, ok := outPaths[abs]; ok {
+				problems = append(problems, fmt.Sprintf("%s and %s both resolve outPath to %s", prior, path, abs))
+			} else {
+				outPaths[abs] = path
+			}
+		}
+
+		if len(known) == 0 {
+			continue
+		}
+		for table := range cfg.FieldNameMap {
+			if !known[table] {
+				problems = append(problems, fmt.Sprintf("%s: fieldNameMap references unknown table %q", path, table))
+			}
+		}
+		for table := range cfg.ColumnNameMap {
+			if !known[table] {
+				problems = append(problems, fmt.Sprintf("%s: columnNameMap references unknown table %q", path, table))
+			}
+		}
+		for table := range cfg.EncryptedColumns {
+			if !known[table] {
+				problems = append(problems, fmt.Sprintf("%s: encryptedColumns references unknown table %q", path, table))
+			}
+		}
+		for table := range cfg.TenantColumns {
+			if !known[table] {
+				problems = append(problems, fmt.Sprintf("%s: tenantColumns references unknown table %q", path, table))
+			}
+		}
+		for table := range cfg.AuditColumns {
+			if !known[table] {
+				problems = append(problems, fmt.Sprintf("%s: auditColumns references unknown table %q", path, table))
+			}
+		}
+	}
+
+	sort.Strings(problems)
+	return problems
+}
@@ -0,0 +1,250 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeConfigFile(t *testing.T, path, contents string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "genconfig.yaml")
+	writeConfigFile(t, path, "forbidUntypedOrder: true\noutPath: ./query\n")
+
+	cfg, err := LoadConfig(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cfg.ForbidUntypedOrder || cfg.OutPath != "./query" {
+		t.Errorf("cfg = %+v, want ForbidUntypedOrder=true, OutPath=./query", cfg)
+	}
+}
+
+func TestFindConfigsWalksTree(t *testing.T) {
+	root := t.TempDir()
+	writeConfigFile(t, filepath.Join(root, "a", "genconfig.yaml"), "outPath: ./qa\n")
+	writeConfigFile(t, filepath.Join(root, "b", "genconfig.yaml"), "outPath: ./qb\n")
+	writeConfigFile(t, filepath.Join(root, "b", "notconfig.yaml"), "outPath: ./ignored\n")
+
+	found, err := FindConfigs(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(found) != 2 {
+		t.Fatalf("found = %v, want 2 genconfig.yaml files", found)
+	}
+}
+
+func TestLoadConfigFileFlat(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "genconfig.yaml")
+	writeConfigFile(t, path, "forbidUntypedOrder: true\n")
+
+	entries, err := LoadConfigFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || !entries[path].ForbidUntypedOrder {
+		t.Errorf("entries = %+v, want one flat entry keyed by the file path", entries)
+	}
+}
+
+func TestLoadConfigFileSectioned(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "genconfig.yaml")
+	writeConfigFile(t, path, `packages:
+  ./query:
+    outPath: ./query
+    forbidUntypedOrder: true
+  ./internal/query:
+    outPath: ./internal/query
+`)
+
+	entries, err := LoadConfigFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("entries = %+v, want two sections", entries)
+	}
+	query := entries[filepath.Join(dir, "query")]
+	if !query.ForbidUntypedOrder || query.OutPath != "./query" {
+		t.Errorf("query section = %+v, want ForbidUntypedOrder=true, OutPath=./query", query)
+	}
+	if _, ok := entries[filepath.Join(dir, "internal", "query")]; !ok {
+		t.Errorf("entries = %+v, want a section for ./internal/query", entries)
+	}
+}
+
+func TestFindConfigsExpandsSectionedRoot(t *testing.T) {
+	root := t.TempDir()
+	writeConfigFile(t, filepath.Join(root, "genconfig.yaml"), `packages:
+  ./a:
+    outPath: ./a
+  ./b:
+    outPath: ./b
+`)
+
+	found, err := FindConfigs(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(found) != 2 {
+		t.Fatalf("found = %v, want two expanded package sections", found)
+	}
+}
+
+func TestMergeConfigsOverlaysMaps(t *testing.T) {
+	configs := map[string]Config{
+		"a/genconfig.yaml": {FieldNameMap: map[string]map[string]string{"users": {"id": "ID"}}},
+		"b/genconfig.yaml": {FieldNameMap: map[string]map[string]string{"users": {"name": "FullName"}}, VariableNameSuffix: "Fields"},
+	}
+
+	merged := MergeConfigs(configs)
+	if merged.FieldNameMap["users"]["id"] != "ID" || merged.FieldNameMap["users"]["name"] != "FullName" {
+		t.Errorf("FieldNameMap = %v, want both entries merged", merged.FieldNameMap)
+	}
+	if merged.VariableNameSuffix != "Fields" {
+		t.Errorf("VariableNameSuffix = %q, want Fields", merged.VariableNameSuffix)
+	}
+}
+
+func TestMergeConfigsOverlaysTenantColumns(t *testing.T) {
+	configs := map[string]Config{
+		"a/genconfig.yaml": {TenantColumns: map[string]string{"orders": "tenant_id"}},
+		"b/genconfig.yaml": {TenantColumns: map[string]string{"invoices": "org_id"}},
+	}
+
+	merged := MergeConfigs(configs)
+	if merged.TenantColumns["orders"] != "tenant_id" || merged.TenantColumns["invoices"] != "org_id" {
+		t.Errorf("TenantColumns = %v, want both entries merged", merged.TenantColumns)
+	}
+}
+
+func TestMergeConfigsOverlaysAuditColumns(t *testing.T) {
+	configs := map[string]Config{
+		"a/genconfig.yaml": {AuditColumns: map[string]AuditColumnSet{"orders": {CreatedBy: "created_by"}}},
+		"b/genconfig.yaml": {AuditColumns: map[string]AuditColumnSet{"invoices": {CreatedBy: "created_by", UpdatedBy: "updated_by"}}},
+	}
+
+	merged := MergeConfigs(configs)
+	if merged.AuditColumns["orders"].CreatedBy != "created_by" {
+		t.Errorf("AuditColumns[orders] = %+v, want CreatedBy created_by", merged.AuditColumns["orders"])
+	}
+	if merged.AuditColumns["invoices"].UpdatedBy != "updated_by" {
+		t.Errorf("AuditColumns[invoices] = %+v, want UpdatedBy updated_by", merged.AuditColumns["invoices"])
+	}
+}
+
+func TestValidateConfigsDetectsOutPathCollision(t *testing.T) {
+	configs := map[string]Config{
+		"a/genconfig.yaml": {OutPath: "../shared"},
+		"b/genconfig.yaml": {OutPath: "../shared"},
+	}
+
+	problems := ValidateConfigs(configs, nil)
+	if len(problems) != 1 {
+		t.Fatalf("problems = %v, want exactly one outPath collision", problems)
+	}
+}
+
+func TestValidateConfigsDetectsUnknownTable(t *testing.T) {
+	configs := map[string]Config{
+		"genconfig.yaml": {FieldNameMap: map[string]map[string]string{"ghosts": {"id": "ID"}}},
+	}
+
+	problems := ValidateConfigs(configs, []string{"users"})
+	if len(problems) != 1 {
+		t.Fatalf("problems = %v, want exactly one unknown-table problem", problems)
+	}
+}
+
+func TestMergeConfigsOverlaysGenerateValidation(t *testing.T) {
+	configs := map[string]Config{
+		"a/genconfig.yaml": {},
+		"b/genconfig.yaml": {GenerateValidation: true},
+	}
+
+	merged := MergeConfigs(configs)
+	if !merged.GenerateValidation {
+		t.Errorf("GenerateValidation = false, want true once any config sets it")
+	}
+}
+
+func TestMergeConfigsOverlaysGenerateBenchmarks(t *testing.T) {
+	configs := map[string]Config{
+		"a/genconfig.yaml": {},
+		"b/genconfig.yaml": {GenerateBenchmarks: true},
+	}
+
+	merged := MergeConfigs(configs)
+	if !merged.GenerateBenchmarks {
+		t.Errorf("GenerateBenchmarks = false, want true once any config sets it")
+	}
+}
+
+func TestMergeConfigsOverlaysEncryptedColumns(t *testing.T) {
+	configs := map[string]Config{
+		"a/genconfig.yaml": {EncryptedColumns: map[string]map[string]string{"users": {"ssn": "aes"}}},
+		"b/genconfig.yaml": {EncryptedColumns: map[string]map[string]string{"users": {"dob": "aes"}}},
+	}
+
+	merged := MergeConfigs(configs)
+	if merged.EncryptedColumns["users"]["ssn"] != "aes" || merged.EncryptedColumns["users"]["dob"] != "aes" {
+		t.Errorf("EncryptedColumns = %v, want both entries merged", merged.EncryptedColumns)
+	}
+}
+
+func TestValidateConfigsDetectsUnknownEncryptedTable(t *testing.T) {
+	configs := map[string]Config{
+		"genconfig.yaml": {EncryptedColumns: map[string]map[string]string{"ghosts": {"ssn": "aes"}}},
+	}
+
+	problems := ValidateConfigs(configs, []string{"users"})
+	if len(problems) != 1 {
+		t.Fatalf("problems = %v, want exactly one unknown-table problem", problems)
+	}
+}
+
+func TestValidateConfigsDetectsUnknownTenantTable(t *testing.T) {
+	configs := map[string]Config{
+		"genconfig.yaml": {TenantColumns: map[string]string{"ghosts": "tenant_id"}},
+	}
+
+	problems := ValidateConfigs(configs, []string{"users"})
+	if len(problems) != 1 {
+		t.Fatalf("problems = %v, want exactly one unknown-table problem", problems)
+	}
+}
+
+func TestValidateConfigsDetectsUnknownAuditTable(t *testing.T) {
+	configs := map[string]Config{
+		"genconfig.yaml": {AuditColumns: map[string]AuditColumnSet{"ghosts": {CreatedBy: "created_by"}}},
+	}
+
+	problems := ValidateConfigs(configs, []string{"users"})
+	if len(problems) != 1 {
+		t.Fatalf("problems = %v, want exactly one unknown-table problem", problems)
+	}
+}
+
+func TestValidateConfigsCleanWhenConsistent(t *testing.T) {
+	configs := map[string]Config{
+		"a/genconfig.yaml": {OutPath: "./qa", FieldNameMap: map[string]map[string]string{"users": {"id": "ID"}}},
+		"b/genconfig.yaml": {OutPath: "./qb"},
+	}
+
+	if problems := ValidateConfigs(configs, []string{"users"}); len(problems) != 0 {
+		t.Errorf("problems = %v, want none", problems)
+	}
+}
@@ -0,0 +1,234 @@
+package generator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// RenderModels renders tables as Go model structs plus one field helper
+// variable per column, the offline counterpart to Render: it needs
+// nothing beyond the parsed schema, so `gen from-sql` can emit usable
+// code in CI with no database to introspect.
+func RenderModels(tables []Table) string {
+	return RenderModelsWithConfig(tables, Config{})
+}
+
+// RenderModelsWithConfig is RenderModels with cfg's settings applied,
+// e.g. a Validate() method per model when GenerateValidation is set.
+func RenderModelsWithConfig(tables []Table, cfg Config) string {
+	sorted := append([]Table(nil), tables...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+
+	needsTime := false
+	needsFmt := false
+	for _, t := range sorted {
+		for _, c := range t.Columns {
+			if goType(c.Type) == "time.Time" {
+				needsTime = true
+			}
+		}
+		if cfg.GenerateValidation && RenderValidateMethod(t) != "" {
+			needsFmt = true
+		}
+	}
+
+	var b strings.Builder
+	b.Grow(totalColumns(sorted) * 64)
+	b.WriteString("// Code generated by gorm gen. DO NOT EDIT.\n\npackage model\n\nimport (\n")
+	if needsFmt {
+		b.WriteString("\t\"fmt\"\n")
+	}
+	if needsTime {
+		b.WriteString("\t\"time\"\n\n")
+	}
+	b.WriteString("\t\"github.com/go-gorm/cli/field\"\n)\n")
+
+	for _, t := range sorted {
+		name := structName(t.Name)
+
+		fmt.Fprintf(&b, "\ntype %s struct {\n", name)
+		for _, c := range t.Columns {
+			writeColumnComment(&b, "\t", c.Comment)
+			tag := "column:" + c.Name
+			if c.Comment != "" {
+				tag += ";comment:" + sanitizeTagComment(c.Comment)
+			}
+			fmt.Fprintf(&b, "\t%s %s `gorm:\"%s\"`\n", goFieldName(c.Name), goType(c.Type), tag)
+		}
+		b.WriteString("}\n")
+
+		if cfg.GenerateValidation {
+			b.WriteString(RenderValidateMethod(t))
+		}
+
+		b.WriteString("\nvar (\n")
+		for _, c := range t.Columns {
+			writeColumnComment(&b, "\t", c.Comment)
+			m := fieldMapping(c)
+			fmt.Fprintf(&b, "\t%s%s = %s(%q, %q)\n", name, goFieldName(c.Name), m.Constructor, t.Name, c.Name)
+		}
+		b.WriteString(")\n")
+
+		if hasSelfReferentialForeignKey(t) {
+			writeSelfJoinFields(&b, name, t)
+		}
+
+		if len(t.PrimaryKeyColumns) > 0 {
+			writePrimaryKeyHelper(&b, name, t)
+		}
+	}
+
+	return b.String()
+}
+
+// hasSelfReferentialForeignKey reports whether t has a foreign key back
+// to itself, e.g. "users.manager_id -> users.id".
+func hasSelfReferentialForeignKey(t Table) bool {
+	for _, fk := range t.ForeignKeys {
+		if fk.RefTable == t.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// selfReferentialForeignKeyColumn returns the column of t's first
+// foreign key back to itself, for use in writeSelfJoinFields' doc
+// comment. Callers must only call this after hasSelfReferentialForeignKey
+// reports true.
+func selfReferentialForeignKeyColumn(t Table) string {
+	for _, fk := range t.ForeignKeys {
+		if fk.RefTable == t.Name {
+			return fk.Column
+		}
+	}
+	return ""
+}
+
+// writeSelfJoinFields emits a <name>Fields struct grouping t's already-
+// rendered field helpers into one value, plus a <name>Self instance of
+// it (named apart from the <name> struct/var pair RenderModels already
+// emits for t, since it can't reuse either without redeclaring them).
+// gen.As/gen.WithTable operate on exactly this shape (a struct whose
+// fields expose their own WithTable method), so a self-join can
+// retarget the whole table to an alias in one call instead of aliasing
+// each field helper individually - the missing piece for querying both
+// sides of a self-relation without ambiguous column errors.
+func writeSelfJoinFields(b *strings.Builder, name string, t Table) {
+	fkColumn := selfReferentialForeignKeyColumn(t)
+	fkField := name + goFieldName(fkColumn)
+	fmt.Fprintf(b, "\n// %sFields groups %s's field helpers into a single value gen.As\n// (or gen.WithTable) can retarget to an alias, for self-joins through\n// %s.%s, so a query touching both sides of the relation doesn't\n// produce ambiguous column errors, e.g.\n//\n//\tparent := gen.As(%sSelf, \"parent\")\n//\tfield.And(%s.EqCol(parent.Id))\ntype %sFields struct {\n", name, t.Name, t.Name, fkColumn, name, fkField, name)
+	for _, c := range t.Columns {
+		m := fieldMapping(c)
+		fmt.Fprintf(b, "\t%s %s\n", goFieldName(c.Name), m.GoType)
+	}
+	b.WriteString("}\n")
+
+	fmt.Fprintf(b, "\nvar %sSelf = %sFields{\n", name, name)
+	for _, c := range t.Columns {
+		fmt.Fprintf(b, "\t%s: %s%s,\n", goFieldName(c.Name), name, goFieldName(c.Name))
+	}
+	b.WriteString("}\n")
+}
+
+// writePrimaryKeyHelper emits a <name>PrimaryKey function wrapping
+// field.PrimaryKey over t's key columns in key order, so callers get a
+// typed delete/update-by-key predicate without having to name each
+// field.KeyValue themselves - the more columns t.PrimaryKeyColumns has,
+// the more positional args key expects, e.g. for an orderlines table
+// keyed on (order_id, line_no):
+//
+//	q.Where(model.OrderlinesPrimaryKey(7, 2)).Delete()
+func writePrimaryKeyHelper(b *strings.Builder, name string, t Table) {
+	fmt.Fprintf(b, "\n// %sPrimaryKey builds an equality predicate matching one row of %s\n// by its primary key, for typed delete/update-by-key, e.g.\n//\n//\tq.Where(%sPrimaryKey(%s)).Delete()\nfunc %sPrimaryKey(key ...interface{}) field.Expr {\n\treturn field.PrimaryKey(\n", name, t.Name, name, primaryKeyExampleArgs(len(t.PrimaryKeyColumns)), name)
+	for i, col := range t.PrimaryKeyColumns {
+		fmt.Fprintf(b, "\t\tfield.KeyValue{Column: %s%s, Value: key[%d]},\n", name, goFieldName(col), i)
+	}
+	b.WriteString("\t)\n}\n")
+}
+
+// primaryKeyExampleArgs renders n placeholder arguments (7, 8, 9, ...)
+// for writePrimaryKeyHelper's doc comment example.
+func primaryKeyExampleArgs(n int) string {
+	args := make([]string, n)
+	for i := range args {
+		args[i] = fmt.Sprintf("%d", i+7)
+	}
+	return strings.Join(args, ", ")
+}
+
+// fieldMapping resolves the field package wrapper generated code uses
+// for a column: a dedicated wrapper when one is registered, otherwise
+// field.String for text-like columns or field.Null[T] (which works for
+// any T, nullable or not) as a generic fallback.
+func fieldMapping(c Column) TypeMapping {
+	if m, ok := LookupTypeMapping(strings.ToLower(c.Type)); ok {
+		return m
+	}
+
+	base := goType(c.Type)
+	if base == "string" {
+		return TypeMapping{GoType: "field.String", Constructor: "field.NewString"}
+	}
+	return WrapNullable(base)
+}
+
+// goType maps a raw SQL column type to the Go type used for its model
+// struct field.
+func goType(sqlType string) string {
+	u := strings.ToUpper(sqlType)
+	switch {
+	case strings.Contains(u, "BOOL"):
+		return "bool"
+	case strings.Contains(u, "INT"):
+		return "int64"
+	case strings.Contains(u, "REAL"), strings.Contains(u, "FLOA"), strings.Contains(u, "DOUB"), strings.Contains(u, "DECIMAL"), strings.Contains(u, "NUMERIC"):
+		return "float64"
+	case strings.Contains(u, "DATE"), strings.Contains(u, "TIME"):
+		return "time.Time"
+	case strings.Contains(u, "BLOB"), strings.Contains(u, "BINARY"):
+		return "[]byte"
+	default:
+		return "string"
+	}
+}
+
+// writeColumnComment writes comment (if any) as a //-prefixed Go doc
+// comment indented by prefix, one line per "\n"-separated line, so a
+// multi-line database comment round-trips into valid Go instead of
+// corrupting the generated file, and editor hover shows it verbatim -
+// including non-ASCII text, since this only ever splits on "\n" and
+// never re-encodes the bytes in between.
+func writeColumnComment(b *strings.Builder, prefix, comment string) {
+	if comment == "" {
+		return
+	}
+	for _, line := range strings.Split(comment, "\n") {
+		fmt.Fprintf(b, "%s// %s\n", prefix, line)
+	}
+}
+
+// sanitizeTagComment prepares a column comment for embedding in a
+// generated gorm struct tag's comment: option. gorm's tag grammar has
+// no quoting - a raw semicolon or backtick would either be parsed as
+// the next tag option or terminate the enclosing raw string - so those
+// characters are replaced rather than escaped. The full, unmodified
+// comment still appears in the doc comment above the field.
+func sanitizeTagComment(comment string) string {
+	replacer := strings.NewReplacer("`", "'", "\"", "'", ";", ",", "\n", " ")
+	return replacer.Replace(comment)
+}
+
+// goFieldName converts a snake_case column name to the PascalCase field
+// name a Go struct exports, e.g. "author_id" -> "AuthorId".
+func goFieldName(column string) string {
+	parts := strings.Split(column, "_")
+	for i, p := range parts {
+		if p == "" {
+			continue
+		}
+		parts[i] = capitalizeFirst(p)
+	}
+	return strings.Join(parts, "")
+}
@@ -0,0 +1,162 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderModels(t *testing.T) {
+	tables := []Table{
+		{
+			Name: "users",
+			Columns: []Column{
+				{Name: "id", Type: "INTEGER", Nullable: false},
+				{Name: "name", Type: "TEXT", Nullable: false},
+				{Name: "verified", Type: "BOOLEAN", Nullable: false},
+			},
+		},
+	}
+
+	code := RenderModels(tables)
+	if !strings.Contains(code, "type Users struct {") {
+		t.Errorf("code = %q, want a Users struct", code)
+	}
+	if !strings.Contains(code, "Id int64 `gorm:\"column:id\"`") {
+		t.Errorf("code = %q, want an Id int64 field", code)
+	}
+	if !strings.Contains(code, "Verified bool") {
+		t.Errorf("code = %q, want a Verified bool field", code)
+	}
+	if !strings.Contains(code, `UsersName = field.NewString("users", "name")`) {
+		t.Errorf("code = %q, want a field.NewString helper for name", code)
+	}
+	if !strings.Contains(code, `UsersVerified = field.NewBool("users", "verified")`) {
+		t.Errorf("code = %q, want a field.NewBool helper for verified", code)
+	}
+	if !strings.Contains(code, `UsersId = field.NewNull[int64]("users", "id")`) {
+		t.Errorf("code = %q, want a field.NewNull[int64] fallback for id", code)
+	}
+}
+
+func TestRenderModelsIncludesColumnComments(t *testing.T) {
+	tables := []Table{
+		{
+			Name: "accounts",
+			Columns: []Column{
+				{Name: "id", Type: "INTEGER", Nullable: false},
+				{Name: "balance", Type: "INTEGER", Nullable: false, Comment: "账户余额，以分为单位"},
+				{Name: "note", Type: "TEXT", Nullable: true, Comment: `has a "quote"; and a backtick ` + "`" + ` in it`},
+			},
+		},
+	}
+
+	code := RenderModels(tables)
+	if !strings.Contains(code, "// 账户余额，以分为单位\n\tBalance") {
+		t.Errorf("code = %q, want the non-ASCII comment above the Balance field", code)
+	}
+	if !strings.Contains(code, "// 账户余额，以分为单位\n\tAccountsBalance") {
+		t.Errorf("code = %q, want the non-ASCII comment above the AccountsBalance field helper", code)
+	}
+	if !strings.Contains(code, `comment:has a 'quote', and a backtick ' in it`) {
+		t.Errorf("code = %q, want the tag comment sanitized of characters that break the gorm tag grammar", code)
+	}
+	if strings.Contains(code, "// \n") {
+		t.Errorf("code = %q, want no comment lines for columns without one", code)
+	}
+}
+
+func TestRenderModelsEmitsSelfJoinFieldsForSelfReferentialForeignKey(t *testing.T) {
+	tables := []Table{
+		{
+			Name: "employees",
+			Columns: []Column{
+				{Name: "id", Type: "INTEGER", Nullable: false},
+				{Name: "manager_id", Type: "INTEGER", Nullable: true},
+			},
+			ForeignKeys: []ForeignKey{{Column: "manager_id", RefTable: "employees"}},
+		},
+	}
+
+	code := RenderModels(tables)
+	if !strings.Contains(code, "type EmployeesFields struct {") {
+		t.Errorf("code = %q, want an EmployeesFields struct for the self-referential table", code)
+	}
+	if !strings.Contains(code, "var EmployeesSelf = EmployeesFields{") {
+		t.Errorf("code = %q, want an EmployeesSelf instance", code)
+	}
+	if !strings.Contains(code, "ManagerId field.Null[int64]") {
+		t.Errorf("code = %q, want the ManagerId field in EmployeesFields", code)
+	}
+}
+
+func TestRenderModelsOmitsSelfJoinFieldsWithoutSelfReferentialForeignKey(t *testing.T) {
+	tables := []Table{
+		{Name: "users", Columns: []Column{{Name: "id", Type: "INTEGER"}}},
+	}
+
+	if code := RenderModels(tables); strings.Contains(code, "Fields struct") {
+		t.Errorf("code = %q, want no *Fields struct for a table without a self-referential foreign key", code)
+	}
+}
+
+func TestRenderModelsEmitsPrimaryKeyHelperForCompositeKey(t *testing.T) {
+	tables := []Table{
+		{
+			Name: "orderlines",
+			Columns: []Column{
+				{Name: "order_id", Type: "INTEGER"},
+				{Name: "line_no", Type: "INTEGER"},
+				{Name: "product", Type: "TEXT"},
+			},
+			PrimaryKeyColumns: []string{"order_id", "line_no"},
+		},
+	}
+
+	code := RenderModels(tables)
+	if !strings.Contains(code, "func OrderlinesPrimaryKey(key ...interface{}) field.Expr {") {
+		t.Errorf("code = %q, want an OrderlinesPrimaryKey helper", code)
+	}
+	if !strings.Contains(code, "field.KeyValue{Column: OrderlinesOrderId, Value: key[0]},") ||
+		!strings.Contains(code, "field.KeyValue{Column: OrderlinesLineNo, Value: key[1]},") {
+		t.Errorf("code = %q, want key.KeyValue pairs in PrimaryKeyColumns order", code)
+	}
+}
+
+func TestRenderModelsOmitsPrimaryKeyHelperWithoutPrimaryKeyColumns(t *testing.T) {
+	tables := []Table{
+		{Name: "events", Columns: []Column{{Name: "payload", Type: "TEXT"}}},
+	}
+
+	if code := RenderModels(tables); strings.Contains(code, "PrimaryKey(key ...interface{})") {
+		t.Errorf("code = %q, want no PrimaryKey helper when PrimaryKeyColumns is empty", code)
+	}
+}
+
+func TestGoFieldName(t *testing.T) {
+	if got := goFieldName("author_id"); got != "AuthorId" {
+		t.Errorf("goFieldName = %q, want AuthorId", got)
+	}
+}
+
+func TestGoFieldNameMultiByteColumn(t *testing.T) {
+	if got := goFieldName("姓名"); got != "姓名" {
+		t.Errorf("goFieldName(%q) = %q, want the multi-byte column name to survive intact rather than split mid-rune", "姓名", got)
+	}
+}
+
+func TestRenderModelsWithMultiByteTableName(t *testing.T) {
+	tables := []Table{
+		{
+			Name:    "咖啡",
+			Columns: []Column{{Name: "id", Type: "INTEGER", Nullable: false}},
+		},
+	}
+
+	code := RenderModels(tables)
+	if !strings.Contains(code, "type 咖啡 struct {") {
+		t.Errorf("code = %q, want a valid 咖啡 struct declaration", code)
+	}
+	if !strings.Contains(code, `咖啡Id = field.NewNull[int64]("咖啡", "id")`) {
+		t.Errorf("code = %q, want an unmangled 咖啡Id field helper", code)
+	}
+}
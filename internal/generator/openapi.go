@@ -0,0 +1,142 @@
+package generator
+
+import "strings"
+
+// Column describes one database column gen introspected, enough to
+// render an OpenAPI schema property for it.
+type Column struct {
+	// Name is the column's name as reported by the driver/information
+	// schema.
+	Name string
+	// Type is the column's raw declared SQL type, e.g. "INTEGER", "TEXT".
+	Type string
+	// Nullable reports whether the column allows NULL.
+	Nullable bool
+	// Size is the column's declared length/precision, e.g. 255 for
+	// VARCHAR(255), or 0 if the type declares none. Populated by
+	// ParseDDL from the type's parenthesized argument; live
+	// introspection paths that don't resolve it leave it zero.
+	Size int
+	// Comment is the column's business-meaning comment, e.g. from a
+	// DDL COMMENT clause, if one was declared. Populated by ParseDDL;
+	// LoadTable's live SQLite introspection leaves it empty since
+	// SQLite has no column comment concept to reflect it from.
+	Comment string
+}
+
+// Table pairs a table name with its introspected columns and
+// foreign keys.
+type Table struct {
+	Name        string
+	Columns     []Column
+	ForeignKeys []ForeignKey
+	// PrimaryKeyColumns lists the table's primary key columns in key
+	// order - one entry for a single-column key, more than one for a
+	// composite key (e.g. an order_lines table keyed on (order_id,
+	// line_no)). Populated by ParseDDL and LoadTable; empty if the
+	// table declares no primary key.
+	PrimaryKeyColumns []string
+}
+
+// ForeignKey describes one of a table's foreign key columns, resolved
+// from the driver/information schema, e.g. "posts.author_id ->
+// users" lets a GraphQL export add a nested "author: Users" relation
+// field alongside the raw scalar column.
+type ForeignKey struct {
+	Column   string
+	RefTable string
+}
+
+// OpenAPIDocument is the subset of the OpenAPI 3 document `gorm gen
+// openapi` writes: just enough structure to host a components/schemas
+// section, with the rest left for the project to merge in by hand.
+type OpenAPIDocument struct {
+	OpenAPI    string            `yaml:"openapi"`
+	Info       OpenAPIInfo       `yaml:"info"`
+	Components OpenAPIComponents `yaml:"components"`
+}
+
+// OpenAPIInfo is an OpenAPI document's required info object.
+type OpenAPIInfo struct {
+	Title   string `yaml:"title"`
+	Version string `yaml:"version"`
+}
+
+// OpenAPIComponents holds the generated model schemas.
+type OpenAPIComponents struct {
+	Schemas map[string]OpenAPISchema `yaml:"schemas"`
+}
+
+// OpenAPISchema is an OpenAPI schema object for one model.
+type OpenAPISchema struct {
+	Type       string                     `yaml:"type"`
+	Properties map[string]OpenAPIProperty `yaml:"properties,omitempty"`
+	Required   []string                   `yaml:"required,omitempty"`
+}
+
+// OpenAPIProperty is an OpenAPI schema property for one column.
+type OpenAPIProperty struct {
+	Type     string `yaml:"type"`
+	Format   string `yaml:"format,omitempty"`
+	Nullable bool   `yaml:"nullable,omitempty"`
+}
+
+// totalColumns sums the column count across tables, so renderers can
+// size their output buffer up front instead of letting it grow one
+// reallocation-and-copy at a time on wide, many-column schemas.
+func totalColumns(tables []Table) int {
+	n := 0
+	for _, t := range tables {
+		n += len(t.Columns)
+	}
+	return n
+}
+
+// BuildOpenAPIDocument converts introspected tables into an OpenAPI
+// components document, so API docs generated from it stay in sync with
+// the database models instead of drifting out of date by hand.
+func BuildOpenAPIDocument(tables []Table) OpenAPIDocument {
+	schemas := make(map[string]OpenAPISchema, len(tables))
+	for _, t := range tables {
+		schema := OpenAPISchema{
+			Type:       "object",
+			Properties: make(map[string]OpenAPIProperty, len(t.Columns)),
+		}
+		for _, c := range t.Columns {
+			typ, format := openAPIType(c.Type)
+			schema.Properties[c.Name] = OpenAPIProperty{Type: typ, Format: format, Nullable: c.Nullable}
+			if !c.Nullable {
+				schema.Required = append(schema.Required, c.Name)
+			}
+		}
+		schemas[structName(t.Name)] = schema
+	}
+
+	return OpenAPIDocument{
+		OpenAPI:    "3.0.3",
+		Info:       OpenAPIInfo{Title: "Generated models", Version: "1.0.0"},
+		Components: OpenAPIComponents{Schemas: schemas},
+	}
+}
+
+// openAPIType maps a raw SQL column type to an OpenAPI type/format
+// pair. Unrecognized types fall back to a bare string, the same
+// permissive default gen itself uses for columns it doesn't have a
+// dedicated field type for.
+func openAPIType(sqlType string) (typ, format string) {
+	sqlType = strings.ToUpper(sqlType)
+	switch {
+	case strings.Contains(sqlType, "INT"):
+		return "integer", ""
+	case strings.Contains(sqlType, "BOOL"):
+		return "boolean", ""
+	case strings.Contains(sqlType, "REAL"), strings.Contains(sqlType, "FLOA"), strings.Contains(sqlType, "DOUB"), strings.Contains(sqlType, "DECIMAL"), strings.Contains(sqlType, "NUMERIC"):
+		return "number", ""
+	case strings.Contains(sqlType, "BLOB"):
+		return "string", "byte"
+	case strings.Contains(sqlType, "DATE") || strings.Contains(sqlType, "TIME"):
+		return "string", "date-time"
+	default:
+		return "string", ""
+	}
+}
@@ -0,0 +1,62 @@
+package generator
+
+import "testing"
+
+func TestBuildOpenAPIDocument(t *testing.T) {
+	doc := BuildOpenAPIDocument([]Table{
+		{
+			Name: "users",
+			Columns: []Column{
+				{Name: "id", Type: "INTEGER", Nullable: false},
+				{Name: "name", Type: "TEXT", Nullable: false},
+				{Name: "nickname", Type: "TEXT", Nullable: true},
+				{Name: "balance", Type: "REAL", Nullable: true},
+			},
+		},
+	})
+
+	if doc.OpenAPI == "" {
+		t.Error("want a non-empty openapi version")
+	}
+
+	schema, ok := doc.Components.Schemas["Users"]
+	if !ok {
+		t.Fatalf("Schemas = %v, want a Users schema", doc.Components.Schemas)
+	}
+	if schema.Properties["id"].Type != "integer" {
+		t.Errorf("id type = %q, want integer", schema.Properties["id"].Type)
+	}
+	if schema.Properties["balance"].Type != "number" {
+		t.Errorf("balance type = %q, want number", schema.Properties["balance"].Type)
+	}
+	if !schema.Properties["nickname"].Nullable {
+		t.Error("want nickname marked nullable")
+	}
+
+	wantRequired := map[string]bool{"id": true, "name": true}
+	if len(schema.Required) != len(wantRequired) {
+		t.Errorf("Required = %v, want exactly %v", schema.Required, wantRequired)
+	}
+	for _, r := range schema.Required {
+		if !wantRequired[r] {
+			t.Errorf("Required contains unexpected %q", r)
+		}
+	}
+}
+
+func TestOpenAPITypeMapping(t *testing.T) {
+	cases := map[string]string{
+		"INTEGER":       "integer",
+		"VARCHAR(255)":  "string",
+		"BOOLEAN":       "boolean",
+		"DOUBLE":        "number",
+		"BLOB":          "string",
+		"DATETIME":      "string",
+		"decimal(10,2)": "number",
+	}
+	for sqlType, want := range cases {
+		if typ, _ := openAPIType(sqlType); typ != want {
+			t.Errorf("openAPIType(%q) = %q, want %q", sqlType, typ, want)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package generator
+
+import (
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// PolicyAnnotation is the retry/timeout policy parsed from an
+// annotated interface method's doc comment, e.g.
+//
+//	// retry: 3, backoff: 100ms
+//	// timeout: 2s
+//	GetByID(ctx context.Context, id int) (*User, error)
+type PolicyAnnotation struct {
+	// MaxAttempts is the total number of attempts, including the
+	// first, parsed from "retry: N". Zero means no retry annotation
+	// was present.
+	MaxAttempts int
+	// Backoff is the delay between attempts, parsed from "backoff: D".
+	Backoff time.Duration
+	// Timeout bounds each attempt, parsed from "timeout: D".
+	Timeout time.Duration
+}
+
+var (
+	policyRetryPattern   = regexp.MustCompile(`(?i)\bretry:\s*(\d+)`)
+	policyBackoffPattern = regexp.MustCompile(`(?i)\bbackoff:\s*([0-9]+(?:\.[0-9]+)?(?:ns|us|µs|ms|s|m|h))`)
+	policyTimeoutPattern = regexp.MustCompile(`(?i)\btimeout:\s*([0-9]+(?:\.[0-9]+)?(?:ns|us|µs|ms|s|m|h))`)
+)
+
+// ParsePolicyAnnotation scans comment - a method's full doc comment,
+// one or more lines - for "retry: N", "backoff: D", and "timeout: D"
+// directives, returning the parsed PolicyAnnotation and whether any
+// directive was found at all.
+func ParsePolicyAnnotation(comment string) (PolicyAnnotation, bool) {
+	var ann PolicyAnnotation
+	found := false
+
+	if m := policyRetryPattern.FindStringSubmatch(comment); m != nil {
+		if n, err := strconv.Atoi(m[1]); err == nil && n > 0 {
+			ann.MaxAttempts = n
+			found = true
+		}
+	}
+	if m := policyBackoffPattern.FindStringSubmatch(comment); m != nil {
+		if d, err := time.ParseDuration(m[1]); err == nil {
+			ann.Backoff = d
+			found = true
+		}
+	}
+	if m := policyTimeoutPattern.FindStringSubmatch(comment); m != nil {
+		if d, err := time.ParseDuration(m[1]); err == nil {
+			ann.Timeout = d
+			found = true
+		}
+	}
+
+	return ann, found
+}
@@ -0,0 +1,46 @@
+package generator
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParsePolicyAnnotationRetryAndBackoff(t *testing.T) {
+	ann, found := ParsePolicyAnnotation("retry: 3, backoff: 100ms")
+	if !found {
+		t.Fatal("want found = true")
+	}
+	if ann.MaxAttempts != 3 {
+		t.Errorf("MaxAttempts = %d, want 3", ann.MaxAttempts)
+	}
+	if ann.Backoff != 100*time.Millisecond {
+		t.Errorf("Backoff = %v, want 100ms", ann.Backoff)
+	}
+}
+
+func TestParsePolicyAnnotationTimeout(t *testing.T) {
+	ann, found := ParsePolicyAnnotation("timeout: 2s")
+	if !found {
+		t.Fatal("want found = true")
+	}
+	if ann.Timeout != 2*time.Second {
+		t.Errorf("Timeout = %v, want 2s", ann.Timeout)
+	}
+}
+
+func TestParsePolicyAnnotationNone(t *testing.T) {
+	_, found := ParsePolicyAnnotation("GetByID looks up a user by primary key.")
+	if found {
+		t.Error("want found = false for a comment with no retry/timeout directives")
+	}
+}
+
+func TestParsePolicyAnnotationMultiline(t *testing.T) {
+	ann, found := ParsePolicyAnnotation("GetByID looks up a user by primary key.\n// retry: 5, backoff: 50ms\n// timeout: 500ms")
+	if !found {
+		t.Fatal("want found = true")
+	}
+	if ann.MaxAttempts != 5 || ann.Backoff != 50*time.Millisecond || ann.Timeout != 500*time.Millisecond {
+		t.Errorf("ann = %+v, want {5 50ms 500ms}", ann)
+	}
+}
@@ -0,0 +1,69 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAssignProtoFieldNumbersFreshStartsAtOne(t *testing.T) {
+	tables := []Table{{Name: "users", Columns: []Column{{Name: "id", Type: "INTEGER"}, {Name: "name", Type: "TEXT"}}}}
+
+	numbers := AssignProtoFieldNumbers(tables, nil)
+	if numbers["Users"]["id"] != 1 || numbers["Users"]["name"] != 2 {
+		t.Errorf("numbers = %v, want id=1, name=2", numbers["Users"])
+	}
+}
+
+func TestAssignProtoFieldNumbersPreservesPrior(t *testing.T) {
+	tables := []Table{{Name: "users", Columns: []Column{
+		{Name: "id", Type: "INTEGER"},
+		{Name: "name", Type: "TEXT"},
+		{Name: "email", Type: "TEXT"},
+	}}}
+	prior := ProtoFieldNumbers{"Users": {"id": 1, "name": 2}}
+
+	numbers := AssignProtoFieldNumbers(tables, prior)
+	if numbers["Users"]["id"] != 1 || numbers["Users"]["name"] != 2 {
+		t.Errorf("numbers = %v, want prior assignments preserved", numbers["Users"])
+	}
+	if numbers["Users"]["email"] != 3 {
+		t.Errorf("email number = %d, want 3 (next free)", numbers["Users"]["email"])
+	}
+}
+
+func TestAssignProtoFieldNumbersNeverReusesDroppedColumn(t *testing.T) {
+	tables := []Table{{Name: "users", Columns: []Column{
+		{Name: "id", Type: "INTEGER"},
+		{Name: "email", Type: "TEXT"},
+	}}}
+	// "name" (field 2) was dropped from the table but its number must
+	// stay retired rather than being handed to the new "email" column.
+	prior := ProtoFieldNumbers{"Users": {"id": 1, "name": 2}}
+
+	numbers := AssignProtoFieldNumbers(tables, prior)
+	if numbers["Users"]["email"] != 3 {
+		t.Errorf("email number = %d, want 3, not the retired 2", numbers["Users"]["email"])
+	}
+}
+
+func TestRenderProto(t *testing.T) {
+	tables := []Table{{Name: "users", Columns: []Column{
+		{Name: "id", Type: "INTEGER"},
+		{Name: "name", Type: "TEXT"},
+	}}}
+	numbers := AssignProtoFieldNumbers(tables, nil)
+
+	out := RenderProto(tables, numbers, "models")
+	if !strings.Contains(out, `package models;`) {
+		t.Errorf("output = %q, want the package statement", out)
+	}
+	if !strings.Contains(out, "message Users {") {
+		t.Errorf("output = %q, want a Users message", out)
+	}
+	if !strings.Contains(out, "int64 id = 1;") {
+		t.Errorf("output = %q, want the id field numbered 1", out)
+	}
+	if !strings.Contains(out, "string name = 2;") {
+		t.Errorf("output = %q, want the name field numbered 2", out)
+	}
+}
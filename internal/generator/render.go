@@ -0,0 +1,49 @@
+package generator
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Render returns the generated code gen would write for table, using
+// the default Config. It is the single source of truth both the write
+// path and `gorm gen --diff` render against, so a diff preview can never
+// drift from what a real run would produce.
+func Render(table string) string {
+	return RenderWithConfig(table, Config{})
+}
+
+// RenderWithConfig is Render with cfg's settings applied, e.g.
+// VariableNamePrefix/VariableNameSuffix on the generated per-model
+// variable.
+func RenderWithConfig(table string, cfg Config) string {
+	return fmt.Sprintf(`// Code generated by gorm gen. DO NOT EDIT.
+
+package query
+
+import "gorm.io/gen"
+
+var %s = gen.Use[%s](db)
+`, cfg.HelperIdentifier(table), structName(table))
+}
+
+// WriteTable renders table with Render and writes it to
+// filepath.Join(output, table+"_gen.go"), returning the path written.
+// It's the single place that pairs a rendered table with its output
+// path, so `gorm gen`'s write path and generate.Run share it instead of
+// each re-deriving "<table>_gen.go" independently and risking the two
+// entry points drifting apart.
+func WriteTable(table, output string) (string, error) {
+	path := filepath.Join(output, table+"_gen.go")
+	if err := os.WriteFile(path, []byte(Render(table)), 0o644); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// structName derives the generated accessor's model type name from a
+// table name, e.g. "users" -> "Users".
+func structName(table string) string {
+	return capitalizeFirst(table)
+}
@@ -0,0 +1,21 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderWithConfigAppliesVariableNameOverrides(t *testing.T) {
+	cfg := Config{VariableNameSuffix: "Fields"}
+
+	got := RenderWithConfig("users", cfg)
+	if !strings.Contains(got, "var usersFields = gen.Use[Users](db)") {
+		t.Errorf("RenderWithConfig() = %q, want the usersFields variable", got)
+	}
+}
+
+func TestRenderUsesDefaultConfig(t *testing.T) {
+	if Render("users") != RenderWithConfig("users", Config{}) {
+		t.Error("Render should match RenderWithConfig with the zero Config")
+	}
+}
@@ -0,0 +1,19 @@
+package generator
+
+// Result summarizes one `gorm gen` run: which tables were processed,
+// which files were written, and anything that didn't go cleanly. It is
+// the payload behind `gorm gen --format json`, for build tooling and
+// editors that want to consume generation results programmatically
+// instead of scraping human-readable log lines.
+type Result struct {
+	Inputs   []string  `json:"inputs"`
+	Outputs  []string  `json:"outputs"`
+	Warnings []string  `json:"warnings,omitempty"`
+	Skipped  []Skipped `json:"skipped,omitempty"`
+}
+
+// Skipped records a table gen chose not to generate code for, and why.
+type Skipped struct {
+	Table  string `json:"table"`
+	Reason string `json:"reason"`
+}
@@ -0,0 +1,165 @@
+package generator
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// SQLCQuery is one sqlc `-- name: Foo :one` annotated query, parsed
+// from a sqlc query file as the source for an equivalent gorm gen
+// annotated interface method.
+type SQLCQuery struct {
+	// Name is the query's sqlc name, e.g. "GetUser", reused verbatim as
+	// the generated interface method name.
+	Name string
+	// Mode is the sqlc execution mode: "one", "many", or "exec".
+	Mode string
+	// SQL is the query body, with its literal primary table name
+	// replaced by the bare gorm gen @@table placeholder, any joined
+	// table replaced by the named form @@table(Model), and positional
+	// parameters replaced by @argN placeholders.
+	SQL string
+	// Table is the table the query's FROM/INTO/UPDATE clause names, or
+	// "" if it couldn't be determined.
+	Table string
+}
+
+var sqlcAnnotationPattern = regexp.MustCompile(`(?im)^--\s*name:\s*(\w+)\s*:(one|many|exec)\s*$`)
+
+var sqlcTablePattern = regexp.MustCompile(`(?i)\b(?:from|into|update)\s+["'` + "`" + `]?(\w+)["'` + "`" + `]?`)
+
+var sqlcJoinTablePattern = regexp.MustCompile(`(?i)\bjoin\s+["'` + "`" + `]?(\w+)["'` + "`" + `]?`)
+
+var sqlcPositionalParamPattern = regexp.MustCompile(`\$(\d+)`)
+
+// sqlcCommentOrStringPattern matches a block comment (including an
+// optimizer hint block like "/*+ ... */"), a line comment, or a
+// single-quoted string literal, the spans replaceIdentifierOutsideComments
+// leaves untouched.
+var sqlcCommentOrStringPattern = regexp.MustCompile(`(?s:/\*.*?\*/)|--[^\n]*|'(?:[^']|'')*'`)
+
+// blankCommentsAndStrings returns sql with every comment and string
+// literal span replaced by spaces of the same length, so a keyword
+// search like sqlcTablePattern can't mistake "from"/"join" mentioned
+// inside a hint comment or a quoted literal for the real clause.
+func blankCommentsAndStrings(sql string) string {
+	return sqlcCommentOrStringPattern.ReplaceAllStringFunc(sql, func(s string) string {
+		return strings.Repeat(" ", len(s))
+	})
+}
+
+// replaceIdentifierOutsideComments replaces every whole-word, case-insensitive
+// match of old in sql with new, skipping matches inside a comment or
+// string literal, so substituting a table name for its @@table
+// placeholder can't also rewrite an occurrence mentioned inside a
+// planner hint comment or a quoted literal.
+func replaceIdentifierOutsideComments(sql, old, new string) string {
+	pattern := regexp.MustCompile(`(?i)\b` + regexp.QuoteMeta(old) + `\b`)
+
+	var b strings.Builder
+	pos := 0
+	for _, r := range sqlcCommentOrStringPattern.FindAllStringIndex(sql, -1) {
+		b.WriteString(pattern.ReplaceAllString(sql[pos:r[0]], new))
+		b.WriteString(sql[r[0]:r[1]])
+		pos = r[1]
+	}
+	b.WriteString(pattern.ReplaceAllString(sql[pos:], new))
+	return b.String()
+}
+
+// ParseSQLCQueries parses the `-- name: Foo :one` annotated queries in
+// src, sqlc's query file format, so a migration off sqlc doesn't have
+// to hand-copy every query into a gorm gen annotated interface.
+func ParseSQLCQueries(src string) ([]SQLCQuery, error) {
+	matches := sqlcAnnotationPattern.FindAllStringSubmatchIndex(src, -1)
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("from-sqlc: no \"-- name: ... :one/:many/:exec\" annotations found")
+	}
+
+	queries := make([]SQLCQuery, 0, len(matches))
+	for i, m := range matches {
+		bodyStart := m[1]
+		bodyEnd := len(src)
+		if i+1 < len(matches) {
+			bodyEnd = matches[i+1][0]
+		}
+		sql := strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(src[bodyStart:bodyEnd]), ";"))
+
+		blanked := blankCommentsAndStrings(sql)
+
+		table := ""
+		if tm := sqlcTablePattern.FindStringSubmatch(blanked); tm != nil {
+			table = tm[1]
+		}
+
+		for _, jm := range sqlcJoinTablePattern.FindAllStringSubmatch(blanked, -1) {
+			joined := jm[1]
+			if joined == table {
+				continue
+			}
+			placeholder := fmt.Sprintf("@@table(%s)", structName(joined))
+			sql = replaceIdentifierOutsideComments(sql, joined, placeholder)
+		}
+		if table != "" {
+			sql = replaceIdentifierOutsideComments(sql, table, "@@table")
+		}
+		sql = sqlcPositionalParamPattern.ReplaceAllString(sql, "@arg$1")
+
+		queries = append(queries, SQLCQuery{
+			Name:  src[m[2]:m[3]],
+			Mode:  src[m[4]:m[5]],
+			SQL:   sql,
+			Table: table,
+		})
+	}
+	return queries, nil
+}
+
+// RenderAnnotatedInterfaces renders queries as gorm gen annotated query
+// interfaces, grouped by the table each query targets, one interface
+// per table plus an untargeted one for any query whose table couldn't
+// be determined.
+func RenderAnnotatedInterfaces(queries []SQLCQuery) string {
+	byTable := map[string][]SQLCQuery{}
+	for _, q := range queries {
+		byTable[q.Table] = append(byTable[q.Table], q)
+	}
+
+	tables := make([]string, 0, len(byTable))
+	for t := range byTable {
+		tables = append(tables, t)
+	}
+	sort.Strings(tables)
+
+	var b strings.Builder
+	b.WriteString("// Code generated by gorm gen. DO NOT EDIT.\n// Converted from sqlc query annotations; review the generated method\n// signatures, the conversion does not infer parameter or result types.\n\npackage query\n")
+
+	for _, table := range tables {
+		qs := byTable[table]
+		name := "Queries"
+		if table != "" {
+			name = structName(table)
+			fmt.Fprintf(&b, "\n// @@table: %s\n", table)
+		}
+		fmt.Fprintf(&b, "type %s interface {\n", name)
+		for _, q := range qs {
+			fmt.Fprintf(&b, "\t// %s\n", q.SQL)
+			fmt.Fprintf(&b, "\t%s(args ...any) %s\n", q.Name, sqlcReturnType(q.Mode))
+		}
+		b.WriteString("}\n")
+	}
+	return b.String()
+}
+
+func sqlcReturnType(mode string) string {
+	switch mode {
+	case "one":
+		return "(*gen.T, error)"
+	case "many":
+		return "([]*gen.T, error)"
+	default:
+		return "error"
+	}
+}
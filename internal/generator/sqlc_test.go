@@ -0,0 +1,109 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+const sqlcFixture = `-- name: GetUser :one
+SELECT * FROM users WHERE id = $1;
+
+-- name: ListUsers :many
+SELECT * FROM users ORDER BY id;
+
+-- name: DeleteUser :exec
+DELETE FROM users WHERE id = $1;
+`
+
+func TestParseSQLCQueries(t *testing.T) {
+	queries, err := ParseSQLCQueries(sqlcFixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(queries) != 3 {
+		t.Fatalf("len(queries) = %d, want 3", len(queries))
+	}
+
+	get := queries[0]
+	if get.Name != "GetUser" || get.Mode != "one" || get.Table != "users" {
+		t.Errorf("queries[0] = %+v", get)
+	}
+	if !strings.Contains(get.SQL, "@@table") {
+		t.Errorf("SQL = %q, want the table name replaced by @@table", get.SQL)
+	}
+	if !strings.Contains(get.SQL, "@arg1") {
+		t.Errorf("SQL = %q, want $1 replaced by @arg1", get.SQL)
+	}
+}
+
+func TestParseSQLCQueriesNamesJoinedTables(t *testing.T) {
+	src := `-- name: ListOrdersForUser :many
+SELECT * FROM orders JOIN users ON orders.user_id = users.id WHERE users.id = $1;
+`
+	queries, err := ParseSQLCQueries(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(queries) != 1 {
+		t.Fatalf("len(queries) = %d, want 1", len(queries))
+	}
+
+	q := queries[0]
+	if q.Table != "orders" {
+		t.Errorf("Table = %q, want %q", q.Table, "orders")
+	}
+	if !strings.Contains(q.SQL, "@@table(Users)") {
+		t.Errorf("SQL = %q, want the joined table named as @@table(Users)", q.SQL)
+	}
+	if !strings.Contains(q.SQL, "FROM @@table") {
+		t.Errorf("SQL = %q, want the primary table left as the bare @@table placeholder", q.SQL)
+	}
+}
+
+func TestParseSQLCQueriesPreservesHintComments(t *testing.T) {
+	src := `-- name: ListUsers :many
+SELECT /*+ INDEX(users idx_users_name) */ * FROM users WHERE id = $1;
+`
+	queries, err := ParseSQLCQueries(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(queries) != 1 {
+		t.Fatalf("len(queries) = %d, want 1", len(queries))
+	}
+
+	q := queries[0]
+	if !strings.Contains(q.SQL, "/*+ INDEX(users idx_users_name) */") {
+		t.Errorf("SQL = %q, want the hint comment preserved verbatim", q.SQL)
+	}
+	if !strings.Contains(q.SQL, "FROM @@table") {
+		t.Errorf("SQL = %q, want the real FROM clause's table replaced by @@table", q.SQL)
+	}
+}
+
+func TestParseSQLCQueriesNoAnnotations(t *testing.T) {
+	if _, err := ParseSQLCQueries("SELECT 1;"); err == nil {
+		t.Fatal("want an error when no sqlc annotations are present")
+	}
+}
+
+func TestRenderAnnotatedInterfaces(t *testing.T) {
+	queries, err := ParseSQLCQueries(sqlcFixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	code := RenderAnnotatedInterfaces(queries)
+	if !strings.Contains(code, "@@table: users") {
+		t.Errorf("code = %q, want a users table annotation", code)
+	}
+	if !strings.Contains(code, "type Users interface {") {
+		t.Errorf("code = %q, want a Users interface", code)
+	}
+	if !strings.Contains(code, "GetUser(args ...any) (*gen.T, error)") {
+		t.Errorf("code = %q, want a GetUser method returning (*gen.T, error)", code)
+	}
+	if !strings.Contains(code, "DeleteUser(args ...any) error") {
+		t.Errorf("code = %q, want a DeleteUser method returning error", code)
+	}
+}
@@ -0,0 +1,126 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// ResolveTableName resolves the table name a model's @@table
+// annotation should expand to: if modelName, or a type it embeds,
+// defines a TableName method - on either a value or pointer receiver -
+// that method's literal return value wins, the same override gorm
+// itself honors at runtime. Otherwise it falls back to
+// defaultTableName's naive pluralization.
+//
+// It resolves the method through go/types' method set rather than a
+// source regex over "func (m Model) TableName()", so a TableName
+// defined on a pointer receiver, or promoted from an embedded type, is
+// found the same way a regex matching only modelName's own literal
+// receiver spelling would miss.
+func ResolveTableName(dir, modelName string) (string, error) {
+	cfg := &packages.Config{
+		Dir:  dir,
+		Mode: packages.NeedName | packages.NeedTypes | packages.NeedSyntax | packages.NeedTypesInfo,
+	}
+	pkgs, err := packages.Load(cfg, ".")
+	if err != nil {
+		return "", err
+	}
+	if len(pkgs) == 0 || pkgs[0].Types == nil {
+		return "", fmt.Errorf("generator: no package found in %s", dir)
+	}
+	pkg := pkgs[0]
+	if len(pkg.Errors) > 0 {
+		return "", fmt.Errorf("generator: %s: %v", dir, pkg.Errors[0])
+	}
+
+	obj := pkg.Types.Scope().Lookup(modelName)
+	named, ok := obj.(*types.TypeName)
+	if !ok {
+		return "", fmt.Errorf("generator: type %s not found in %s", modelName, dir)
+	}
+
+	methodSet := types.NewMethodSet(types.NewPointer(named.Type()))
+	sel := methodSet.Lookup(pkg.Types, "TableName")
+	if sel == nil {
+		return defaultTableName(modelName), nil
+	}
+	fn, ok := sel.Obj().(*types.Func)
+	if !ok {
+		return defaultTableName(modelName), nil
+	}
+
+	if lit, ok := tableNameLiteral(pkg, fn.Pos()); ok {
+		return lit, nil
+	}
+	return defaultTableName(modelName), nil
+}
+
+// tableNameLiteral finds the FuncDecl named at pos across pkg's parsed
+// files and returns the string literal its body returns, if its only
+// return statement is that simple.
+func tableNameLiteral(pkg *packages.Package, pos token.Pos) (string, bool) {
+	for _, file := range pkg.Syntax {
+		var lit string
+		var found bool
+		ast.Inspect(file, func(n ast.Node) bool {
+			if found {
+				return false
+			}
+			decl, ok := n.(*ast.FuncDecl)
+			if !ok || decl.Name.Pos() != pos || decl.Body == nil {
+				return true
+			}
+			for _, stmt := range decl.Body.List {
+				ret, ok := stmt.(*ast.ReturnStmt)
+				if !ok || len(ret.Results) != 1 {
+					continue
+				}
+				basic, ok := ret.Results[0].(*ast.BasicLit)
+				if !ok || basic.Kind != token.STRING {
+					continue
+				}
+				if unquoted, err := strconv.Unquote(basic.Value); err == nil {
+					lit, found = unquoted, true
+				}
+			}
+			return false
+		})
+		if found {
+			return lit, true
+		}
+	}
+	return "", false
+}
+
+// defaultTableName derives a table name from a model name the way gorm
+// itself does absent a TableName override: snake_cased and naively
+// pluralized, e.g. "UserProfile" -> "user_profiles".
+func defaultTableName(modelName string) string {
+	return pluralize(pascalToSnake(modelName))
+}
+
+func pascalToSnake(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if i > 0 && unicode.IsUpper(r) {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+func pluralize(s string) string {
+	if strings.HasSuffix(s, "s") {
+		return s
+	}
+	return s + "s"
+}
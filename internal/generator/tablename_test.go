@@ -0,0 +1,97 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeTestModule(t *testing.T, src string) string {
+	t.Helper()
+	dir := t.TempDir()
+	goMod := "module tablenametest\n\ngo 1.22.0\n"
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte(goMod), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "model.go"), []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestResolveTableNameDefault(t *testing.T) {
+	dir := writeTestModule(t, `package model
+
+type UserProfile struct {
+	ID int
+}
+`)
+
+	got, err := ResolveTableName(dir, "UserProfile")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "user_profiles" {
+		t.Errorf("ResolveTableName = %q, want %q", got, "user_profiles")
+	}
+}
+
+func TestResolveTableNameValueReceiver(t *testing.T) {
+	dir := writeTestModule(t, `package model
+
+type User struct {
+	ID int
+}
+
+func (User) TableName() string { return "app_users" }
+`)
+
+	got, err := ResolveTableName(dir, "User")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "app_users" {
+		t.Errorf("ResolveTableName = %q, want %q", got, "app_users")
+	}
+}
+
+func TestResolveTableNamePointerReceiver(t *testing.T) {
+	dir := writeTestModule(t, `package model
+
+type User struct {
+	ID int
+}
+
+func (*User) TableName() string { return "app_users" }
+`)
+
+	got, err := ResolveTableName(dir, "User")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "app_users" {
+		t.Errorf("ResolveTableName = %q, want %q", got, "app_users")
+	}
+}
+
+func TestResolveTableNamePromotedFromEmbeddedType(t *testing.T) {
+	dir := writeTestModule(t, `package model
+
+type Base struct{}
+
+func (*Base) TableName() string { return "base_table" }
+
+type User struct {
+	Base
+	ID int
+}
+`)
+
+	got, err := ResolveTableName(dir, "User")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "base_table" {
+		t.Errorf("ResolveTableName = %q, want %q", got, "base_table")
+	}
+}
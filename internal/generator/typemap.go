@@ -0,0 +1,44 @@
+// Package generator holds the code generation engine behind `gorm gen`:
+// parsing annotated interfaces, resolving column types, and rendering
+// the field/gen packages' types into per-model generated code.
+package generator
+
+// TypeMapping describes which field package type and constructor a
+// database column type resolves to during generation.
+type TypeMapping struct {
+	// GoType is the field package type used in generated struct fields, e.g. "field.Geometry".
+	GoType string
+	// Constructor is the field package constructor generated code calls, e.g. "field.NewGeometry".
+	Constructor string
+}
+
+// defaultTypeMappings covers the column types gen recognizes without
+// any per-project configuration. Keys are lower-cased database column
+// types as reported by the driver/information schema.
+var defaultTypeMappings = map[string]TypeMapping{
+	"bool":       {GoType: "field.Bool", Constructor: "field.NewBool"},
+	"boolean":    {GoType: "field.Bool", Constructor: "field.NewBool"},
+	"geometry":   {GoType: "field.Geometry", Constructor: "field.NewGeometry"},
+	"geography":  {GoType: "field.Geometry", Constructor: "field.NewGeometry"},
+	"point":      {GoType: "field.Geometry", Constructor: "field.NewGeometry"},
+	"polygon":    {GoType: "field.Geometry", Constructor: "field.NewGeometry"},
+	"linestring": {GoType: "field.Geometry", Constructor: "field.NewGeometry"},
+}
+
+// LookupTypeMapping returns the TypeMapping registered for a database
+// column type, and whether one was found.
+func LookupTypeMapping(columnType string) (TypeMapping, bool) {
+	m, ok := defaultTypeMappings[columnType]
+	return m, ok
+}
+
+// WrapNullable builds the TypeMapping used for a nullable column of
+// underlying Go type goType: pointer and sql.Null* model fields
+// generate as field.Null[T] instead of the plain type, so NULL stays
+// distinct from the zero value in generated queries.
+func WrapNullable(goType string) TypeMapping {
+	return TypeMapping{
+		GoType:      "field.Null[" + goType + "]",
+		Constructor: "field.NewNull[" + goType + "]",
+	}
+}
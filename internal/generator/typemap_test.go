@@ -0,0 +1,13 @@
+package generator
+
+import "testing"
+
+func TestWrapNullable(t *testing.T) {
+	m := WrapNullable("string")
+	if m.GoType != "field.Null[string]" {
+		t.Errorf("GoType = %q", m.GoType)
+	}
+	if m.Constructor != "field.NewNull[string]" {
+		t.Errorf("Constructor = %q", m.Constructor)
+	}
+}
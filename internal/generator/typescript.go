@@ -0,0 +1,56 @@
+package generator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// RenderTypeScript renders tables as TypeScript interfaces, one per
+// table, with camelCase field names matching how Go's json package
+// would marshal them and nullable columns widened to "| null", so a
+// full-stack repo's frontend types can be regenerated from the same
+// schema as the backend models instead of drifting out of sync by hand.
+func RenderTypeScript(tables []Table) string {
+	sorted := append([]Table(nil), tables...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+
+	var b strings.Builder
+	b.Grow(totalColumns(sorted) * 32)
+	b.WriteString("// Code generated by gorm gen. DO NOT EDIT.\n")
+	for _, t := range sorted {
+		fmt.Fprintf(&b, "\nexport interface %s {\n", structName(t.Name))
+		for _, c := range t.Columns {
+			typ := tsType(c.Type)
+			if c.Nullable {
+				typ += " | null"
+			}
+			fmt.Fprintf(&b, "  %s: %s;\n", tsFieldName(c.Name), typ)
+		}
+		b.WriteString("}\n")
+	}
+	return b.String()
+}
+
+// tsFieldName converts a snake_case column name to the lowerCamelCase
+// name a json:"authorId"-tagged Go field would marshal as.
+func tsFieldName(column string) string {
+	return graphQLFieldName(column)
+}
+
+// tsType maps a raw SQL column type to the TypeScript type its JSON
+// representation decodes to.
+func tsType(sqlType string) string {
+	switch goType(sqlType) {
+	case "int64", "float64":
+		return "number"
+	case "bool":
+		return "boolean"
+	case "time.Time":
+		return "string"
+	case "[]byte":
+		return "string"
+	default:
+		return "string"
+	}
+}
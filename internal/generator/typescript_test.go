@@ -0,0 +1,30 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderTypeScript(t *testing.T) {
+	tables := []Table{
+		{
+			Name: "posts",
+			Columns: []Column{
+				{Name: "id", Type: "INTEGER", Nullable: false},
+				{Name: "author_id", Type: "INTEGER", Nullable: false},
+				{Name: "body", Type: "TEXT", Nullable: true},
+			},
+		},
+	}
+
+	out := RenderTypeScript(tables)
+	if !strings.Contains(out, "export interface Posts {") {
+		t.Errorf("out = %q, want a Posts interface", out)
+	}
+	if !strings.Contains(out, "authorId: number;") {
+		t.Errorf("out = %q, want a camelCase authorId field", out)
+	}
+	if !strings.Contains(out, "body: string | null;") {
+		t.Errorf("out = %q, want a nullable body field", out)
+	}
+}
@@ -0,0 +1,36 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RenderValidateMethod renders t's generated Validate() method, one
+// check per string column with a not-null or size constraint. Other
+// column types are skipped: a zero value (0, false, the time.Time
+// zero) doesn't distinguish "not set" from "legitimately zero", so
+// only string columns get a meaningful not-null check here. It returns
+// "" when t has no column worth checking, so RenderModels can skip an
+// empty method entirely.
+func RenderValidateMethod(t Table) string {
+	name := structName(t.Name)
+
+	var checks strings.Builder
+	for _, c := range t.Columns {
+		if goType(c.Type) != "string" {
+			continue
+		}
+		field := goFieldName(c.Name)
+		if !c.Nullable {
+			fmt.Fprintf(&checks, "\tif m.%s == \"\" {\n\t\treturn fmt.Errorf(\"%s: %s must not be empty\")\n\t}\n", field, t.Name, c.Name)
+		}
+		if c.Size > 0 {
+			fmt.Fprintf(&checks, "\tif len(m.%s) > %d {\n\t\treturn fmt.Errorf(\"%s: %s exceeds size %d\")\n\t}\n", field, c.Size, t.Name, c.Name, c.Size)
+		}
+	}
+	if checks.Len() == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("\nfunc (m *%s) Validate() error {\n%s\treturn nil\n}\n", name, checks.String())
+}
@@ -0,0 +1,72 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderValidateMethodChecksNotNullAndSize(t *testing.T) {
+	table := Table{
+		Name: "users",
+		Columns: []Column{
+			{Name: "id", Type: "INTEGER", Nullable: false},
+			{Name: "email", Type: "VARCHAR(255)", Nullable: false, Size: 255},
+			{Name: "bio", Type: "TEXT", Nullable: true},
+		},
+	}
+
+	code := RenderValidateMethod(table)
+	if !strings.Contains(code, "func (m *Users) Validate() error {") {
+		t.Fatalf("code = %q, want a Validate method on Users", code)
+	}
+	if !strings.Contains(code, `m.Email == ""`) {
+		t.Errorf("code = %q, want a not-null check on Email", code)
+	}
+	if !strings.Contains(code, "len(m.Email) > 255") {
+		t.Errorf("code = %q, want a size check on Email", code)
+	}
+	if strings.Contains(code, "m.Bio") {
+		t.Errorf("code = %q, want no check for nullable Bio", code)
+	}
+	if strings.Contains(code, "m.Id") {
+		t.Errorf("code = %q, want no check for non-string Id", code)
+	}
+}
+
+func TestRenderValidateMethodEmptyWhenNothingToCheck(t *testing.T) {
+	table := Table{
+		Name: "counters",
+		Columns: []Column{
+			{Name: "count", Type: "INTEGER", Nullable: false},
+		},
+	}
+
+	if code := RenderValidateMethod(table); code != "" {
+		t.Errorf("code = %q, want empty string when no column needs a check", code)
+	}
+}
+
+func TestRenderModelsWithConfigEmitsValidateMethod(t *testing.T) {
+	tables := []Table{
+		{
+			Name: "users",
+			Columns: []Column{
+				{Name: "id", Type: "INTEGER", Nullable: false},
+				{Name: "email", Type: "VARCHAR(255)", Nullable: false, Size: 255},
+			},
+		},
+	}
+
+	code := RenderModelsWithConfig(tables, Config{GenerateValidation: true})
+	if !strings.Contains(code, "\"fmt\"") {
+		t.Errorf("code = %q, want an fmt import", code)
+	}
+	if !strings.Contains(code, "func (m *Users) Validate() error {") {
+		t.Errorf("code = %q, want a Validate method", code)
+	}
+
+	without := RenderModels(tables)
+	if strings.Contains(without, "Validate() error") {
+		t.Errorf("without GenerateValidation, code = %q, want no Validate method", without)
+	}
+}
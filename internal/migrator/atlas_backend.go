@@ -0,0 +1,50 @@
+package migrator
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// AtlasBackend delegates planning to the Atlas CLI (atlasgo.io), for
+// databases with schema features the gorm backend's direct column diff
+// doesn't understand (partial indexes, generated columns, complex
+// Postgres types, ...). It shells out to the "atlas" binary the way
+// `gorm <plugin>` shells out to gorm-<plugin> binaries, rather than
+// vendoring Atlas's SDK directly.
+type AtlasBackend struct {
+	// AtlasPath is the atlas binary to run. Defaults to "atlas" on PATH.
+	AtlasPath string
+}
+
+func (b AtlasBackend) Plan(dsn, schemaPath string) (Plan, error) {
+	atlasPath := b.AtlasPath
+	if atlasPath == "" {
+		atlasPath = "atlas"
+	}
+	if _, err := exec.LookPath(atlasPath); err != nil {
+		return Plan{}, fmt.Errorf("migrator: atlas CLI not found on PATH (%w); install it from https://atlasgo.io", err)
+	}
+
+	cmd := exec.Command(atlasPath, "schema", "diff",
+		"--from", dsn,
+		"--to", "file://"+schemaPath,
+		"--format", `{{ sql . "\n" }}`,
+	)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return Plan{}, fmt.Errorf("migrator: atlas schema diff: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	var plan Plan
+	for _, line := range strings.Split(stdout.String(), "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" {
+			plan.Statements = append(plan.Statements, line)
+		}
+	}
+	return plan, nil
+}
@@ -0,0 +1,47 @@
+package migrator
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func writeFakeAtlas(t *testing.T, script string) string {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("fake atlas script is a shell script")
+	}
+	dir := t.TempDir()
+	path := filepath.Join(dir, "atlas")
+	if err := os.WriteFile(path, []byte("#!/bin/sh\n"+script), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestAtlasBackendParsesStatements(t *testing.T) {
+	atlas := writeFakeAtlas(t, `printf 'ALTER TABLE users ADD COLUMN bio text;\nCREATE INDEX idx_users_name ON users (name);\n'`)
+
+	plan, err := AtlasBackend{AtlasPath: atlas}.Plan("postgres://localhost/test", "schema.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan.Statements) != 2 {
+		t.Fatalf("plan.Statements = %v, want 2 statements", plan.Statements)
+	}
+}
+
+func TestAtlasBackendPropagatesFailure(t *testing.T) {
+	atlas := writeFakeAtlas(t, `echo "boom" >&2; exit 1`)
+
+	if _, err := (AtlasBackend{AtlasPath: atlas}).Plan("dsn", "schema.sql"); err == nil {
+		t.Fatal("want an error when atlas exits non-zero")
+	}
+}
+
+func TestAtlasBackendMissingBinary(t *testing.T) {
+	if _, err := (AtlasBackend{AtlasPath: filepath.Join(t.TempDir(), "no-such-atlas")}).Plan("dsn", "schema.sql"); err == nil {
+		t.Fatal("want an error when the atlas binary can't be found")
+	}
+}
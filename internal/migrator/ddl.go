@@ -0,0 +1,48 @@
+package migrator
+
+import (
+	"fmt"
+
+	"github.com/go-gorm/cli/internal/generator"
+)
+
+// Dialect names a SQL dialect AddColumnStatement can render DDL for.
+// GormBackend only ever opens a live connection with DialectSQLite in
+// this build - github.com/glebarez/sqlite is the only first-party
+// driver vendored here - but AddColumnStatement's rendering for the
+// other three is exercised directly by tests, ready for a GormBackend
+// that can open a MySQL/Postgres/SQL Server connection once one of
+// those drivers is added as a dependency.
+type Dialect string
+
+const (
+	DialectSQLite    Dialect = "sqlite"
+	DialectMySQL     Dialect = "mysql"
+	DialectPostgres  Dialect = "postgres"
+	DialectSQLServer Dialect = "sqlserver"
+)
+
+// quoteIdent quotes name the way d expects identifiers quoted:
+// double quotes for sqlite/postgres, backticks for mysql, brackets for
+// sqlserver.
+func (d Dialect) quoteIdent(name string) string {
+	switch d {
+	case DialectMySQL:
+		return "`" + name + "`"
+	case DialectSQLServer:
+		return "[" + name + "]"
+	default: // DialectSQLite, DialectPostgres
+		return `"` + name + `"`
+	}
+}
+
+// AddColumnStatement renders the ALTER TABLE ... ADD COLUMN statement
+// for c on table, quoting the table and column identifiers the way d
+// expects and appending NOT NULL when c isn't nullable.
+func AddColumnStatement(d Dialect, table string, c generator.Column) string {
+	stmt := fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", d.quoteIdent(table), d.quoteIdent(c.Name), c.Type)
+	if !c.Nullable {
+		stmt += " NOT NULL"
+	}
+	return stmt
+}
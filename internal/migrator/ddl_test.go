@@ -0,0 +1,44 @@
+package migrator
+
+import (
+	"testing"
+
+	"github.com/go-gorm/cli/internal/generator"
+)
+
+func TestAddColumnStatementAcrossDialects(t *testing.T) {
+	col := generator.Column{Name: "bio", Type: "TEXT", Nullable: true}
+
+	cases := []struct {
+		dialect Dialect
+		want    string
+	}{
+		{DialectSQLite, `ALTER TABLE "users" ADD COLUMN "bio" TEXT`},
+		{DialectMySQL, "ALTER TABLE `users` ADD COLUMN `bio` TEXT"},
+		{DialectPostgres, `ALTER TABLE "users" ADD COLUMN "bio" TEXT`},
+		{DialectSQLServer, `ALTER TABLE [users] ADD COLUMN [bio] TEXT`},
+	}
+	for _, c := range cases {
+		if got := AddColumnStatement(c.dialect, "users", col); got != c.want {
+			t.Errorf("AddColumnStatement(%s) = %q, want %q", c.dialect, got, c.want)
+		}
+	}
+}
+
+func TestAddColumnStatementAppendsNotNull(t *testing.T) {
+	col := generator.Column{Name: "name", Type: "TEXT", Nullable: false}
+	got := AddColumnStatement(DialectPostgres, "users", col)
+	want := `ALTER TABLE "users" ADD COLUMN "name" TEXT NOT NULL`
+	if got != want {
+		t.Errorf("AddColumnStatement = %q, want %q", got, want)
+	}
+}
+
+func TestGormBackendRejectsNonSQLiteDialect(t *testing.T) {
+	dsn := newTestDB(t)
+	schema := writeSchema(t, `CREATE TABLE users (id INTEGER NOT NULL PRIMARY KEY, name TEXT NOT NULL);`)
+
+	if _, err := (GormBackend{Dialect: DialectPostgres}).Plan(dsn, schema); err == nil {
+		t.Fatal("want an error when the gorm backend is asked to plan a non-sqlite dialect")
+	}
+}
@@ -0,0 +1,63 @@
+package migrator
+
+import "strings"
+
+// Phase classifies a planned DDL statement for a blue/green
+// (expand-contract) rollout, where old and new application code must
+// both keep working against the schema between the two phases.
+type Phase int
+
+const (
+	// PhaseExpand statements are purely additive and safe to run
+	// before deploying the new application version: adding a nullable
+	// column, granting a privilege, creating a role.
+	PhaseExpand Phase = iota
+	// PhaseContract statements remove or tighten something the old
+	// application version still depends on - a NOT NULL column
+	// addition, a DROP, a REVOKE - and must wait until after the new
+	// version has fully rolled out.
+	PhaseContract
+)
+
+func (p Phase) String() string {
+	if p == PhaseExpand {
+		return "expand"
+	}
+	return "contract"
+}
+
+// ClassifyStatement decides which Phase stmt belongs to from its SQL
+// text. It recognizes the DDL shapes this package's statement builders
+// (AddColumnStatement, GrantStatement, RevokeStatement,
+// CreateRoleStatement) produce; anything it doesn't recognize,
+// including any DROP, defaults to PhaseContract so an unfamiliar
+// statement gets reviewed rather than run early by default.
+func ClassifyStatement(stmt string) Phase {
+	upper := strings.ToUpper(strings.TrimSpace(stmt))
+	switch {
+	case strings.HasPrefix(upper, "ALTER TABLE") && strings.Contains(upper, "ADD COLUMN"):
+		if strings.Contains(upper, "NOT NULL") {
+			return PhaseContract
+		}
+		return PhaseExpand
+	case strings.HasPrefix(upper, "GRANT"), strings.HasPrefix(upper, "CREATE ROLE"):
+		return PhaseExpand
+	default:
+		return PhaseContract
+	}
+}
+
+// SplitExpandContract partitions plan's statements into an expand Plan
+// (safe to run before deploying the new application version) and a
+// contract Plan (to run only after), preserving each statement's
+// relative order within its phase.
+func SplitExpandContract(plan Plan) (expand, contract Plan) {
+	for _, stmt := range plan.Statements {
+		if ClassifyStatement(stmt) == PhaseExpand {
+			expand.Statements = append(expand.Statements, stmt)
+		} else {
+			contract.Statements = append(contract.Statements, stmt)
+		}
+	}
+	return expand, contract
+}
@@ -0,0 +1,40 @@
+package migrator
+
+import "testing"
+
+func TestClassifyStatement(t *testing.T) {
+	cases := []struct {
+		stmt string
+		want Phase
+	}{
+		{`ALTER TABLE "users" ADD COLUMN "bio" TEXT`, PhaseExpand},
+		{`ALTER TABLE "users" ADD COLUMN "bio" TEXT NOT NULL`, PhaseContract},
+		{`GRANT SELECT ON "orders" TO "reporting"`, PhaseExpand},
+		{`CREATE ROLE "reporting"`, PhaseExpand},
+		{`REVOKE SELECT ON "orders" FROM "reporting"`, PhaseContract},
+		{`DROP TABLE "orders"`, PhaseContract},
+		{`ALTER TABLE "users" DROP COLUMN "bio"`, PhaseContract},
+	}
+	for _, c := range cases {
+		if got := ClassifyStatement(c.stmt); got != c.want {
+			t.Errorf("ClassifyStatement(%q) = %v, want %v", c.stmt, got, c.want)
+		}
+	}
+}
+
+func TestSplitExpandContractPreservesOrder(t *testing.T) {
+	plan := Plan{Statements: []string{
+		`ALTER TABLE "users" ADD COLUMN "bio" TEXT`,
+		`ALTER TABLE "users" ADD COLUMN "verified" BOOLEAN NOT NULL`,
+		`GRANT SELECT ON "orders" TO "reporting"`,
+		`DROP TABLE "legacy"`,
+	}}
+
+	expand, contract := SplitExpandContract(plan)
+	if len(expand.Statements) != 2 || expand.Statements[0] != plan.Statements[0] || expand.Statements[1] != plan.Statements[2] {
+		t.Errorf("expand = %v, want the additive statements in order", expand.Statements)
+	}
+	if len(contract.Statements) != 2 || contract.Statements[0] != plan.Statements[1] || contract.Statements[1] != plan.Statements[3] {
+		t.Errorf("contract = %v, want the removing/tightening statements in order", contract.Statements)
+	}
+}
@@ -0,0 +1,98 @@
+package migrator
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/go-gorm/cli/internal/generator"
+)
+
+// GormBackend is the default Backend. It diffs each desired table's
+// columns directly against what's live and plans ADD COLUMN statements
+// for anything missing, quoted and typed for Dialect.
+//
+// It deliberately doesn't plan CREATE TABLE, column type changes,
+// drops, or renames: those need a backend with a real schema model,
+// such as AtlasBackend, to plan safely.
+//
+// Only DialectSQLite can actually open a live connection in this
+// build - github.com/glebarez/sqlite is the only first-party driver
+// vendored here. Plan rejects any other Dialect up front rather than
+// silently opening a SQLite connection against a MySQL/Postgres/SQL
+// Server dsn.
+//
+// Plan doesn't reflect grants into its diff: SQLite, the only dialect
+// it can actually connect to, has no role/grant model for it to
+// reflect (see CreateRoleStatement/GrantStatement/RevokeStatement).
+type GormBackend struct {
+	// Dialect selects both the live-introspection query and the
+	// quoting/typing AddColumnStatement uses. The zero value defaults
+	// to DialectSQLite.
+	Dialect Dialect
+}
+
+func (b GormBackend) Plan(dsn, schemaPath string) (Plan, error) {
+	dialect := b.Dialect
+	if dialect == "" {
+		dialect = DialectSQLite
+	}
+	if dialect != DialectSQLite {
+		return Plan{}, fmt.Errorf("migrator: gorm backend can't open a live %s connection in this build; use --backend=atlas", dialect)
+	}
+
+	data, err := os.ReadFile(schemaPath)
+	if err != nil {
+		return Plan{}, err
+	}
+	desired, err := generator.ParseDDL(string(data))
+	if err != nil {
+		return Plan{}, err
+	}
+
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{})
+	if err != nil {
+		return Plan{}, err
+	}
+
+	var plan Plan
+	for _, want := range desired {
+		have, err := liveColumns(db, want.Name)
+		if err != nil {
+			return Plan{}, err
+		}
+		if have == nil {
+			return Plan{}, fmt.Errorf("migrator: table %q does not exist; CREATE TABLE planning is not supported by the gorm backend", want.Name)
+		}
+
+		for _, c := range want.Columns {
+			if have[c.Name] {
+				continue
+			}
+			plan.Statements = append(plan.Statements, AddColumnStatement(dialect, want.Name, c))
+		}
+	}
+	return plan, nil
+}
+
+// liveColumns returns the set of column names table currently has, or
+// nil if table doesn't exist.
+func liveColumns(db *gorm.DB, table string) (map[string]bool, error) {
+	var rows []struct {
+		Name string `gorm:"column:name"`
+	}
+	if err := db.Raw("SELECT name FROM pragma_table_info(?)", table).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	columns := make(map[string]bool, len(rows))
+	for _, r := range rows {
+		columns[r.Name] = true
+	}
+	return columns, nil
+}
@@ -0,0 +1,67 @@
+package migrator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+)
+
+func newTestDB(t *testing.T) string {
+	t.Helper()
+	dsn := filepath.Join(t.TempDir(), "test.sqlite")
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Exec("CREATE TABLE users (id INTEGER NOT NULL PRIMARY KEY, name TEXT NOT NULL)").Error; err != nil {
+		t.Fatal(err)
+	}
+	return dsn
+}
+
+func writeSchema(t *testing.T, sql string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "schema.sql")
+	if err := os.WriteFile(path, []byte(sql), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestGormBackendPlansMissingColumn(t *testing.T) {
+	dsn := newTestDB(t)
+	schema := writeSchema(t, `CREATE TABLE users (id INTEGER NOT NULL PRIMARY KEY, name TEXT NOT NULL, bio TEXT);`)
+
+	plan, err := GormBackend{}.Plan(dsn, schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan.Statements) != 1 || plan.Statements[0] != `ALTER TABLE "users" ADD COLUMN "bio" TEXT` {
+		t.Errorf("plan.Statements = %v, want a single ADD COLUMN bio statement", plan.Statements)
+	}
+}
+
+func TestGormBackendUpToDate(t *testing.T) {
+	dsn := newTestDB(t)
+	schema := writeSchema(t, `CREATE TABLE users (id INTEGER NOT NULL PRIMARY KEY, name TEXT NOT NULL);`)
+
+	plan, err := GormBackend{}.Plan(dsn, schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan.Statements) != 0 {
+		t.Errorf("plan.Statements = %v, want none", plan.Statements)
+	}
+}
+
+func TestGormBackendMissingTable(t *testing.T) {
+	dsn := newTestDB(t)
+	schema := writeSchema(t, `CREATE TABLE posts (id INTEGER NOT NULL PRIMARY KEY);`)
+
+	if _, err := (GormBackend{}).Plan(dsn, schema); err == nil {
+		t.Fatal("want an error when the desired table doesn't exist")
+	}
+}
@@ -0,0 +1,46 @@
+package migrator
+
+import "fmt"
+
+// Grant describes a privilege on a table granted to, or revoked from,
+// a role, for GrantStatement/RevokeStatement to render as
+// dialect-correct DDL.
+type Grant struct {
+	Privilege string // e.g. "SELECT", "INSERT", "ALL"
+	Table     string
+	Role      string
+}
+
+// errNoGrantModel is returned by CreateRoleStatement/GrantStatement/
+// RevokeStatement for DialectSQLite: SQLite has no role or privilege
+// system to target, so there's no DDL to render, unlike a missing
+// driver which is a build-environment limitation rather than a
+// property of the dialect itself.
+func errNoGrantModel(d Dialect, action string) error {
+	return fmt.Errorf("migrator: %s has no role/grant model to %s against", d, action)
+}
+
+// CreateRoleStatement renders CREATE ROLE for d.
+func CreateRoleStatement(d Dialect, role string) (string, error) {
+	if d == DialectSQLite {
+		return "", errNoGrantModel(d, "create a role")
+	}
+	return fmt.Sprintf("CREATE ROLE %s", d.quoteIdent(role)), nil
+}
+
+// GrantStatement renders GRANT g.Privilege ON g.Table TO g.Role for d.
+func GrantStatement(d Dialect, g Grant) (string, error) {
+	if d == DialectSQLite {
+		return "", errNoGrantModel(d, "grant a privilege")
+	}
+	return fmt.Sprintf("GRANT %s ON %s TO %s", g.Privilege, d.quoteIdent(g.Table), d.quoteIdent(g.Role)), nil
+}
+
+// RevokeStatement renders REVOKE g.Privilege ON g.Table FROM g.Role
+// for d.
+func RevokeStatement(d Dialect, g Grant) (string, error) {
+	if d == DialectSQLite {
+		return "", errNoGrantModel(d, "revoke a privilege")
+	}
+	return fmt.Sprintf("REVOKE %s ON %s FROM %s", g.Privilege, d.quoteIdent(g.Table), d.quoteIdent(g.Role)), nil
+}
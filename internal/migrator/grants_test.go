@@ -0,0 +1,59 @@
+package migrator
+
+import "testing"
+
+func TestGrantStatementAcrossDialects(t *testing.T) {
+	g := Grant{Privilege: "SELECT", Table: "users", Role: "reporting"}
+
+	cases := []struct {
+		dialect Dialect
+		want    string
+	}{
+		{DialectMySQL, "GRANT SELECT ON `users` TO `reporting`"},
+		{DialectPostgres, `GRANT SELECT ON "users" TO "reporting"`},
+		{DialectSQLServer, `GRANT SELECT ON [users] TO [reporting]`},
+	}
+	for _, c := range cases {
+		got, err := GrantStatement(c.dialect, g)
+		if err != nil {
+			t.Fatalf("GrantStatement(%s): %v", c.dialect, err)
+		}
+		if got != c.want {
+			t.Errorf("GrantStatement(%s) = %q, want %q", c.dialect, got, c.want)
+		}
+	}
+}
+
+func TestRevokeStatement(t *testing.T) {
+	g := Grant{Privilege: "INSERT", Table: "orders", Role: "app"}
+	got, err := RevokeStatement(DialectPostgres, g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `REVOKE INSERT ON "orders" FROM "app"`
+	if got != want {
+		t.Errorf("RevokeStatement = %q, want %q", got, want)
+	}
+}
+
+func TestCreateRoleStatement(t *testing.T) {
+	got, err := CreateRoleStatement(DialectMySQL, "reporting")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "CREATE ROLE `reporting`" {
+		t.Errorf("CreateRoleStatement = %q, want backtick-quoted role", got)
+	}
+}
+
+func TestGrantHelpersRejectSQLite(t *testing.T) {
+	if _, err := CreateRoleStatement(DialectSQLite, "x"); err == nil {
+		t.Error("want an error creating a role on sqlite")
+	}
+	if _, err := GrantStatement(DialectSQLite, Grant{Privilege: "SELECT", Table: "x", Role: "y"}); err == nil {
+		t.Error("want an error granting on sqlite")
+	}
+	if _, err := RevokeStatement(DialectSQLite, Grant{Privilege: "SELECT", Table: "x", Role: "y"}); err == nil {
+		t.Error("want an error revoking on sqlite")
+	}
+}
@@ -0,0 +1,52 @@
+package migrator
+
+import (
+	"sort"
+	"time"
+)
+
+// AppliedMigration records one migration that has been applied to a
+// database. AppliedAt is always stored in UTC; FormatAppliedAt controls
+// how it's displayed.
+//
+// Nothing in this package persists AppliedMigration yet - there is no
+// schema_migrations table or equivalent ledger behind `gorm migrate`,
+// which only plans schema diffs (see Backend.Plan). AppliedMigration
+// and the helpers below are the ordering/display primitives a future
+// `gorm migrate status`/apply command would need once it can populate
+// them from a real ledger.
+type AppliedMigration struct {
+	Name      string
+	AppliedAt time.Time
+}
+
+// SortForDown orders applied the way a `down` would roll migrations
+// back: reverse migration-name order, with AppliedAt as a tiebreaker
+// (also newest-first) so migrations whose names collide, e.g. two
+// generated in the same second, still roll back deterministically. It
+// sorts a copy and leaves applied untouched.
+func SortForDown(applied []AppliedMigration) []AppliedMigration {
+	sorted := append([]AppliedMigration{}, applied...)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Name != sorted[j].Name {
+			return sorted[i].Name > sorted[j].Name
+		}
+		return sorted[i].AppliedAt.After(sorted[j].AppliedAt)
+	})
+	return sorted
+}
+
+// FormatAppliedAt renders m's AppliedAt for display. AppliedAt is
+// stored UTC; local converts it to the machine's local time zone
+// first. layout is a time.Format layout string, e.g. "2006-01-02
+// 15:04"; an empty layout defaults to time.RFC3339.
+func FormatAppliedAt(m AppliedMigration, local bool, layout string) string {
+	t := m.AppliedAt.UTC()
+	if local {
+		t = t.Local()
+	}
+	if layout == "" {
+		layout = time.RFC3339
+	}
+	return t.Format(layout)
+}
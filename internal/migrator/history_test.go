@@ -0,0 +1,52 @@
+package migrator
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSortForDownOrdersByNameDescending(t *testing.T) {
+	applied := []AppliedMigration{
+		{Name: "20240101_create_users", AppliedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{Name: "20240102_add_index", AppliedAt: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)},
+	}
+
+	sorted := SortForDown(applied)
+	if sorted[0].Name != "20240102_add_index" || sorted[1].Name != "20240101_create_users" {
+		t.Errorf("sorted = %v, want newest migration name first", sorted)
+	}
+	if applied[0].Name != "20240101_create_users" {
+		t.Errorf("applied = %v, want SortForDown to leave its input untouched", applied)
+	}
+}
+
+func TestSortForDownBreaksTiesOnAppliedAt(t *testing.T) {
+	earlier := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	later := time.Date(2024, 1, 1, 12, 0, 5, 0, time.UTC)
+	applied := []AppliedMigration{
+		{Name: "20240101_same_second", AppliedAt: earlier},
+		{Name: "20240101_same_second", AppliedAt: later},
+	}
+
+	sorted := SortForDown(applied)
+	if !sorted[0].AppliedAt.Equal(later) {
+		t.Errorf("sorted[0].AppliedAt = %v, want the later apply first", sorted[0].AppliedAt)
+	}
+}
+
+func TestFormatAppliedAtDefaultsToUTCRFC3339(t *testing.T) {
+	m := AppliedMigration{Name: "x", AppliedAt: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)}
+	if got := FormatAppliedAt(m, false, ""); got != "2024-01-01T12:00:00Z" {
+		t.Errorf("FormatAppliedAt = %q, want RFC3339 UTC", got)
+	}
+}
+
+func TestFormatAppliedAtLocalAndCustomLayout(t *testing.T) {
+	m := AppliedMigration{Name: "x", AppliedAt: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)}
+
+	got := FormatAppliedAt(m, true, "2006-01-02 15:04")
+	want := m.AppliedAt.Local().Format("2006-01-02 15:04")
+	if got != want {
+		t.Errorf("FormatAppliedAt = %q, want %q", got, want)
+	}
+}
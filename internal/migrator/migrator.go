@@ -0,0 +1,21 @@
+// Package migrator plans the DDL statements needed to bring a
+// database's live schema in line with a desired schema definition. It
+// backs `gorm migrate plan`, whose --backend flag selects which
+// Backend does the planning: "gorm" diffs columns directly; "atlas"
+// delegates to the Atlas CLI for schema features the direct diff can't
+// represent.
+package migrator
+
+// Plan is the ordered list of DDL statements a Backend determined
+// would reconcile a database's schema with its desired definition. An
+// empty Plan means the schema is already up to date.
+type Plan struct {
+	Statements []string
+}
+
+// Backend plans the DDL statements needed to bring the database at dsn
+// in line with the CREATE TABLE statements in the DDL file at
+// schemaPath.
+type Backend interface {
+	Plan(dsn, schemaPath string) (Plan, error)
+}
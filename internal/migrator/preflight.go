@@ -0,0 +1,45 @@
+package migrator
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Migration pairs a pending migration's name with its reverse
+// statements, for Preflight to check reversibility before an Up run
+// applies it. Down is nil when the migration has no reverse, e.g. a
+// DROP COLUMN nobody bothered to make round-trippable.
+type Migration struct {
+	Name string
+	Down []string
+}
+
+// ErrIrreversibleMigration is the sentinel Preflight wraps when
+// requireDown is true and pending contains a migration with a nil Down.
+var ErrIrreversibleMigration = errors.New("migrator: migration has no Down")
+
+// Preflight checks pending for irreversible migrations before an Up
+// run applies them. Like RunUp, it isn't wired into a CLI command yet -
+// `gorm migrate` doesn't have an Up subcommand or a `--require-down`
+// flag to source requireDown from, and `gorm init` doesn't scaffold a
+// migrate section into genconfig.yaml for it either. If requireDown is
+// true, it stops at the first
+// migration with a nil Down and returns ErrIrreversibleMigration naming
+// it. Otherwise it reports each one to onWarn (if non-nil) and returns
+// nil, so a bootstrap run can still proceed as long as applying an
+// irreversible migration was a deliberate choice rather than an
+// oversight.
+func Preflight(pending []Migration, requireDown bool, onWarn func(name string)) error {
+	for _, m := range pending {
+		if m.Down != nil {
+			continue
+		}
+		if requireDown {
+			return fmt.Errorf("%w: %s", ErrIrreversibleMigration, m.Name)
+		}
+		if onWarn != nil {
+			onWarn(m.Name)
+		}
+	}
+	return nil
+}
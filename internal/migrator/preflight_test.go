@@ -0,0 +1,44 @@
+package migrator
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPreflightWarnsOnIrreversibleMigrations(t *testing.T) {
+	pending := []Migration{
+		{Name: "a", Down: []string{"DROP TABLE a"}},
+		{Name: "b", Down: nil},
+	}
+
+	var warned []string
+	err := Preflight(pending, false, func(name string) { warned = append(warned, name) })
+	if err != nil {
+		t.Fatalf("err = %v, want nil when requireDown is false", err)
+	}
+	if len(warned) != 1 || warned[0] != "b" {
+		t.Errorf("warned = %v, want just b", warned)
+	}
+}
+
+func TestPreflightFailsWhenDownRequired(t *testing.T) {
+	pending := []Migration{
+		{Name: "a", Down: []string{"DROP TABLE a"}},
+		{Name: "b", Down: nil},
+	}
+
+	err := Preflight(pending, true, nil)
+	if !errors.Is(err, ErrIrreversibleMigration) {
+		t.Fatalf("err = %v, want ErrIrreversibleMigration", err)
+	}
+	if err.Error() != "migrator: migration has no Down: b" {
+		t.Errorf("err = %q, want it to name the migration", err)
+	}
+}
+
+func TestPreflightCleanWhenAllReversible(t *testing.T) {
+	pending := []Migration{{Name: "a", Down: []string{"DROP TABLE a"}}}
+	if err := Preflight(pending, true, nil); err != nil {
+		t.Errorf("err = %v, want nil", err)
+	}
+}
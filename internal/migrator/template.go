@@ -0,0 +1,73 @@
+package migrator
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+// Vars are the values a migration SQL file can reference as
+// {{.Schema}} and {{.Env}}, so the same file can target a
+// differently-named schema (e.g. a per-tenant or per-branch database)
+// across environments without hand-editing it per deploy.
+type Vars struct {
+	Schema string
+	Env    string
+}
+
+// includeDirectivePrefix starts a "-- include: path" line, the only
+// directive PreprocessSQL recognizes for pulling in another file.
+const includeDirectivePrefix = "-- include:"
+
+// PreprocessSQL expands vars.Schema/vars.Env template references and
+// "-- include: path" directives in sql before it's parsed as DDL.
+// Included paths are resolved relative to dir (typically the directory
+// of the file sql came from) and are themselves preprocessed, so an
+// included file can use variables or nest further includes.
+func PreprocessSQL(sql string, vars Vars, dir string) (string, error) {
+	var out strings.Builder
+	scanner := bufio.NewScanner(strings.NewReader(sql))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if rest, ok := strings.CutPrefix(strings.TrimSpace(line), includeDirectivePrefix); ok {
+			included, err := preprocessIncluded(strings.TrimSpace(rest), vars, dir)
+			if err != nil {
+				return "", err
+			}
+			out.WriteString(included)
+			out.WriteByte('\n')
+			continue
+		}
+		out.WriteString(line)
+		out.WriteByte('\n')
+	}
+	if err := scanner.Err(); err != nil {
+		return "", fmt.Errorf("migrator: reading SQL for preprocessing: %w", err)
+	}
+
+	tmpl, err := template.New("migration").Parse(out.String())
+	if err != nil {
+		return "", fmt.Errorf("migrator: parsing migration template: %w", err)
+	}
+	var rendered strings.Builder
+	if err := tmpl.Execute(&rendered, vars); err != nil {
+		return "", fmt.Errorf("migrator: rendering migration template: %w", err)
+	}
+	return rendered.String(), nil
+}
+
+func preprocessIncluded(path string, vars Vars, dir string) (string, error) {
+	full := path
+	if !filepath.IsAbs(full) {
+		full = filepath.Join(dir, path)
+	}
+	data, err := os.ReadFile(full)
+	if err != nil {
+		return "", fmt.Errorf("migrator: include %q: %w", path, err)
+	}
+	return PreprocessSQL(string(data), vars, filepath.Dir(full))
+}
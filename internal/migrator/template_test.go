@@ -0,0 +1,42 @@
+package migrator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPreprocessSQLExpandsVars(t *testing.T) {
+	sql := `CREATE TABLE {{.Schema}}.users (id INTEGER, env TEXT DEFAULT '{{.Env}}');`
+	got, err := PreprocessSQL(sql, Vars{Schema: "tenant_a", Env: "staging"}, ".")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `CREATE TABLE tenant_a.users (id INTEGER, env TEXT DEFAULT 'staging');`
+	if got != want+"\n" {
+		t.Errorf("got = %q, want %q", got, want)
+	}
+}
+
+func TestPreprocessSQLExpandsIncludes(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "columns.sql"), []byte("id INTEGER,\n  name TEXT"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	sql := "CREATE TABLE {{.Schema}}.users (\n-- include: columns.sql\n);"
+	got, err := PreprocessSQL(sql, Vars{Schema: "public"}, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "CREATE TABLE public.users (\nid INTEGER,\n  name TEXT\n\n);\n"
+	if got != want {
+		t.Errorf("got = %q, want %q", got, want)
+	}
+}
+
+func TestPreprocessSQLIncludeMissingFile(t *testing.T) {
+	if _, err := PreprocessSQL("-- include: nope.sql", Vars{}, t.TempDir()); err == nil {
+		t.Fatal("want an error for a missing include")
+	}
+}
@@ -0,0 +1,62 @@
+package migrator
+
+import (
+	"context"
+	"time"
+)
+
+// Progress reports how far an Up run has gotten. RunUp emits one to
+// onCheckpoint every checkpointEvery migrations, so a bootstrap run
+// applying hundreds of pending migrations stays observable instead of
+// going silent until it finishes or fails.
+type Progress struct {
+	Applied int
+	Total   int
+}
+
+// Result is the outcome of applying a single migration within an Up
+// run. Duration is how long apply took for that migration; Err is
+// non-nil only for the migration RunUp stopped at.
+type Result struct {
+	Name     string
+	Duration time.Duration
+	Err      error
+}
+
+// RunUp applies pending in order via apply, reporting a Progress to
+// onCheckpoint (if non-nil) every checkpointEvery migrations;
+// checkpointEvery <= 0 disables checkpoint reporting entirely. RunUp
+// stops at the first migration apply fails - it doesn't retry - and
+// returns every Result gathered so far, including the failing one, so
+// a caller can persist them and resume the run starting after the last
+// success.
+//
+// RunUp checks ctx before starting each migration, not while one is
+// in flight: a canceled ctx (--timeout elapsing, or SIGINT/SIGTERM via
+// signal.NotifyContext) stops the run after the current migration
+// finishes rather than aborting it partway through. apply is
+// responsible for running its migration inside its own transaction and
+// rolling it back on failure - RunUp has no transaction handle of its
+// own to roll back.
+func RunUp(ctx context.Context, pending []string, checkpointEvery int, apply func(name string) error, onCheckpoint func(Progress)) []Result {
+	results := make([]Result, 0, len(pending))
+	for i, name := range pending {
+		if err := ctx.Err(); err != nil {
+			results = append(results, Result{Name: name, Err: err})
+			return results
+		}
+
+		started := time.Now()
+		err := apply(name)
+		results = append(results, Result{Name: name, Duration: time.Since(started), Err: err})
+		if err != nil {
+			return results
+		}
+
+		applied := i + 1
+		if onCheckpoint != nil && checkpointEvery > 0 && applied%checkpointEvery == 0 {
+			onCheckpoint(Progress{Applied: applied, Total: len(pending)})
+		}
+	}
+	return results
+}
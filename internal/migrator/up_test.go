@@ -0,0 +1,85 @@
+package migrator
+
+import (
+	"context"
+	"testing"
+)
+
+func TestRunUpAppliesAllInOrder(t *testing.T) {
+	var applied []string
+	results := RunUp(context.Background(), []string{"a", "b", "c"}, 0, func(name string) error {
+		applied = append(applied, name)
+		return nil
+	}, nil)
+
+	if len(applied) != 3 || applied[0] != "a" || applied[2] != "c" {
+		t.Errorf("applied = %v, want a, b, c in order", applied)
+	}
+	if len(results) != 3 {
+		t.Fatalf("results = %v, want 3 results", results)
+	}
+	for _, r := range results {
+		if r.Err != nil {
+			t.Errorf("result %v has an error, want none", r)
+		}
+	}
+}
+
+func TestRunUpStopsAtFirstFailure(t *testing.T) {
+	boom := errText("boom")
+	var applied []string
+	results := RunUp(context.Background(), []string{"a", "b", "c"}, 0, func(name string) error {
+		applied = append(applied, name)
+		if name == "b" {
+			return boom
+		}
+		return nil
+	}, nil)
+
+	if len(applied) != 2 {
+		t.Errorf("applied = %v, want RunUp to stop after b", applied)
+	}
+	if len(results) != 2 || results[1].Err != boom {
+		t.Errorf("results = %v, want the last result to carry the failure", results)
+	}
+}
+
+func TestRunUpCheckpointsEveryN(t *testing.T) {
+	var checkpoints []Progress
+	RunUp(context.Background(), []string{"a", "b", "c", "d", "e"}, 2, func(string) error { return nil }, func(p Progress) {
+		checkpoints = append(checkpoints, p)
+	})
+
+	if len(checkpoints) != 2 {
+		t.Fatalf("checkpoints = %v, want 2 (after b and after d)", checkpoints)
+	}
+	if checkpoints[0].Applied != 2 || checkpoints[1].Applied != 4 {
+		t.Errorf("checkpoints = %v, want Applied 2 then 4", checkpoints)
+	}
+	if checkpoints[0].Total != 5 {
+		t.Errorf("checkpoints[0].Total = %d, want 5", checkpoints[0].Total)
+	}
+}
+
+func TestRunUpStopsAfterCurrentMigrationWhenCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var applied []string
+	results := RunUp(ctx, []string{"a", "b", "c"}, 0, func(name string) error {
+		applied = append(applied, name)
+		if name == "a" {
+			cancel()
+		}
+		return nil
+	}, nil)
+
+	if len(applied) != 1 || applied[0] != "a" {
+		t.Errorf("applied = %v, want RunUp to finish a, then stop before b", applied)
+	}
+	if len(results) != 2 || results[1].Err == nil {
+		t.Fatalf("results = %v, want a second result carrying the cancellation", results)
+	}
+}
+
+type errText string
+
+func (e errText) Error() string { return string(e) }
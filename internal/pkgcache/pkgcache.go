@@ -0,0 +1,86 @@
+// Package pkgcache caches the outcome of expensive go/packages.Load
+// calls across process invocations, keyed by a hash of the target
+// module's go.mod/go.sum, so repeated runs of commands that type-check
+// a module (loading gorm.io/gorm's schema package alone dominates that
+// cost) skip the reload when nothing the load depends on has changed.
+//
+// go/packages' *packages.Package values hold unexported compiler state
+// that doesn't survive serialization across process boundaries, so
+// this package caches a caller-supplied, JSON-serializable summary of
+// a load's outcome instead of the packages themselves.
+package pkgcache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+// Dir returns the directory cache entries are stored under, creating
+// it if it doesn't already exist.
+func Dir() (string, error) {
+	base, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	dir := filepath.Join(base, "gorm-cli", "pkgcache")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+	return dir, nil
+}
+
+// ModuleKey hashes the go.mod and go.sum files (when present) in dir,
+// so a cache entry keyed by it invalidates itself the moment either
+// file changes.
+func ModuleKey(dir string) (string, error) {
+	h := sha256.New()
+	for _, name := range []string{"go.mod", "go.sum"} {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return "", err
+		}
+		h.Write(data)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// Load unmarshals the entry stored under key into dest, reporting
+// whether one was found. A missing entry is not an error.
+func Load(key string, dest interface{}) (bool, error) {
+	dir, err := Dir()
+	if err != nil {
+		return false, err
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, key+".json"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	if err := json.Unmarshal(data, dest); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Store persists value under key for a later Load to find.
+func Store(key string, value interface{}) error {
+	dir, err := Dir()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, key+".json"), data, 0o644)
+}
@@ -0,0 +1,74 @@
+package pkgcache
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+type entry struct {
+	OK     bool
+	Detail string
+}
+
+func TestStoreAndLoad(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	key := "test-key"
+	want := entry{OK: true, Detail: "3 packages loaded"}
+	if err := Store(key, want); err != nil {
+		t.Fatal(err)
+	}
+
+	var got entry
+	ok, err := Load(key, &got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok || got != want {
+		t.Errorf("Load = (%+v, %v), want (%+v, true)", got, ok, want)
+	}
+}
+
+func TestLoadMissingKey(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	var got entry
+	ok, err := Load("does-not-exist", &got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok {
+		t.Error("Load ok = true, want false for a missing key")
+	}
+}
+
+func TestModuleKeyChangesWithContent(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module a\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	k1, err := ModuleKey(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module b\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	k2, err := ModuleKey(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if k1 == k2 {
+		t.Error("ModuleKey did not change when go.mod content changed")
+	}
+}
+
+func TestModuleKeyMissingFiles(t *testing.T) {
+	if _, err := ModuleKey(t.TempDir()); err != nil {
+		t.Fatalf("ModuleKey with no go.mod/go.sum = %v, want no error", err)
+	}
+}